@@ -17,9 +17,21 @@
 package binder
 
 import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"encoding"
+	"encoding/base64"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"math"
+	"net"
+	"net/url"
 	"reflect"
+	"sort"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/xgfone/go-defaults"
@@ -45,17 +57,79 @@ func Bind(dstptr, src interface{}) error {
 	return DefaultBinder.Bind(dstptr, src)
 }
 
+// BindContext uses DefaultBinder to bind dstptr to src with the context.
+func BindContext(ctx context.Context, dstptr, src interface{}) error {
+	return DefaultBinder.BindContext(ctx, dstptr, src)
+}
+
 // BindWithTag is used to bind dstptr to src,
 // which uses the given tag to try to get the field name.
 func BindWithTag(dstptr, src interface{}, tag string) error {
+	return newBinderWithTag(tag).Bind(dstptr, src)
+}
+
+func newBinderWithTag(tag string) Binder {
 	binder := NewBinder()
 	binder.GetFieldName = assists.StructFieldNameFuncWithTags(tag)
+	return binder
+}
+
+// BindWithTags is used to bind dstptr to src, which uses the given tags
+// in turn to try to get the field name. See Binder.Tags.
+func BindWithTags(dstptr, src interface{}, tags ...string) error {
+	binder := NewBinder()
+	binder.Tags = tags
 	return binder.Bind(dstptr, src)
 }
 
 // Hook is used to intercept the binding operation.
 type Hook func(dst reflect.Value, src interface{}) (newsrc interface{}, err error)
 
+// PathHook is the same as Hook, but also receives the dotted path
+// of the value to be bound, such as "secrets.password".
+type PathHook func(path string, dst reflect.Value, src interface{}) (newsrc interface{}, err error)
+
+// HookFromConverters returns a Hook which dispatches the source
+// to the converter by the type of the destination value, the result
+// of which is used as the new source to go on binding.
+//
+// For the type that is not in converters, the source is returned unchanged.
+func HookFromConverters(converters map[reflect.Type]func(src interface{}) (interface{}, error)) Hook {
+	return func(dst reflect.Value, src interface{}) (interface{}, error) {
+		if convert, ok := converters[dst.Type()]; ok {
+			return convert(src)
+		}
+		return src, nil
+	}
+}
+
+// ComposeHooks composes a group of hooks, which will be called in turn
+// with the new source returned by the previous hook, to a Hook.
+//
+// If a hook returns an error or the nil source, stop and return it.
+func ComposeHooks(hooks ...Hook) Hook {
+	if len(hooks) == 0 {
+		panic("ComposeHooks: missing hooks")
+	}
+
+	return func(dst reflect.Value, src interface{}) (newsrc interface{}, err error) {
+		newsrc = src
+		for _, hook := range hooks {
+			if newsrc, err = hook(dst, newsrc); err != nil || newsrc == nil {
+				return
+			}
+		}
+		return
+	}
+}
+
+// ConvertKey is the key of the converter, which consists of the types
+// of the source and destination values.
+type ConvertKey struct {
+	From reflect.Type
+	To   reflect.Type
+}
+
 // Binder is a common binder to bind a value to any.
 //
 // In general, Binder is used to transform a value between different types.
@@ -64,13 +138,76 @@ type Binder struct {
 	// to a single value on demand by the bound value.
 	ConvertSliceToSingle bool
 
+	// SliceToSingleIndex is the index of the element used by ConvertSliceToSingle,
+	// the negative of which counts from the end, such as -1 for the last.
+	//
+	// If out of range, it is clamped to the first or last element.
+	//
+	// Default: 0
+	SliceToSingleIndex int
+
+	// If true, return an error when ConvertSliceToSingle converts
+	// the slice/array source with more than one element to a single value,
+	// such as "?id=1&id=2" to "id int", instead of dropping the rest silently.
+	//
+	// Default: false
+	ErrorOnMultiToSingle bool
+
 	// if true, convert src from a single value to slice/array on demand
-	// by the bound value.
+	// by the bound value, such as "a" to []string{"a"}.
+	//
+	// Or, return an error when binding a single value to slice/array.
 	ConvertSingleToSlice bool
 
+	// If true, bind the map source to slice/array by the values of the map,
+	// such as map[string]Item to []Item.
+	//
+	// The order of the elements is unspecified like the map iteration
+	// unless SortMapToSlice is true.
+	//
+	// Default: false
+	MapToSlice bool
+
+	// If true, bind the map source to slice/array by the keys of the map,
+	// such as map[string]Item to []string, which takes precedence over
+	// MapToSlice.
+	//
+	// The order of the elements is unspecified like the map iteration
+	// unless SortMapToSlice is true.
+	//
+	// Default: false
+	MapKeysToSlice bool
+
+	// If true, sort the keys of the map source in ascending order
+	// for MapToSlice and MapKeysToSlice, so the elements of slice/array
+	// are deterministic. The keys of integer, float and string are compared
+	// by their values, and others by their formatted strings by fmt.Sprint.
+	//
+	// Default: false
+	SortMapToSlice bool
+
+	// TagName is the tag to get the field name, such as "form",
+	// which is equal to set GetFieldName to
+	//   assists.StructFieldNameFuncWithTags(TagName)
+	//
+	// It is used only when GetFieldName is nil, and takes precedence over Tags.
+	//
+	// Default: ""
+	TagName string
+
+	// Tags is a list of the tags in priority order to get the field name,
+	// which uses the first tag whose name is not empty and not "-".
+	// If no such tag, the field is ignored if any tag is "-",
+	// or use the field name instead.
+	//
+	// It is used only when GetFieldName is nil.
+	//
+	// Default: nil
+	Tags []string
+
 	// GetFieldName is used to get the name and arg of the given field.
 	//
-	// If nil, use defaults.GetStructFieldName instead.
+	// If nil, use TagName or Tags if set, or defaults.GetStructFieldName instead.
 	//
 	// If ignoring the field, return the empty string for the field name.
 	// For the tag value, it maybe contain the argument, just like
@@ -83,8 +220,48 @@ type Binder struct {
 	//       Ignore2     int `json:"-,"`
 	//   }
 	//
-	// For the field argument, it only supports "squash" to squash
-	// all the fields of the struct, just like the anonymous field.
+	// For the field argument, it supports
+	//   - "squash": squash all the fields of the struct, just like the anonymous field.
+	//   - "squash=prefix": the same as "squash", but look up the fields of
+	//     the struct by the keys with the prefix, such as "squash=shipping_".
+	//     Only the prefixed key is used, and the unprefixed key is ignored.
+	//   - "inline" and "inline=prefix": the alias of "squash" and "squash=prefix".
+	//   - "asString": store a numeric source into the string field as its plain
+	//     decimal form, such as "1000000000000000000000" for 1e21, which also
+	//     supports the named numeric types, such as `type Amount float64`,
+	//     which cannot be bound to the string field by default.
+	//   - "base64": decode the base64 string source by the standard encoding
+	//     before binding it. If the field is a struct, map, slice except []byte,
+	//     or the pointer to them, the decoded bytes are unmarshaled as JSON,
+	//     such as the base64-encoded JSON payload in the envelope.
+	//   - "json": unmarshal the JSON object or array string source, such as
+	//     the query `filter={"status":"open"}`, before binding it if the field
+	//     is a struct, map, slice except []byte, or the pointer to them.
+	//     The string source which does not look like JSON is bound as usual.
+	//   - "explode=false": split the single string source, or each string
+	//     of the []string source, by ExplodeSeparator for the slice/array field,
+	//     such as the query `tags=a,b,c` like the OpenAPI parameter
+	//     with `style: form, explode: false`. The empty string is split
+	//     into the empty slice.
+	//   - "maxlen=N": return an error if the length of the string or []byte
+	//     source, or any string of the []string source, exceeds N for the field
+	//     of []byte or encoding.TextUnmarshaler.
+	//   - "omitempty": leave the field untouched if the source value is empty,
+	//     such as nil, "", 0, or the empty slice/map, which is useful for
+	//     the partial update. Notice: the validation, such as "required",
+	//     runs after binding, so it checks the kept value of the field.
+	//
+	// Besides, the tag "binder" supports the options of the field and
+	// the elements of the slice/array field, which are separated by "dive":
+	//   - "required": return an error if the source value is missing or empty.
+	//   - "omitempty": skip the empty source value, and the empty element
+	//     is dropped from the slice.
+	// For example,
+	//   type S struct {
+	//       Items  []Item     `json:"items" binder:"required,dive,required"`
+	//       Tags   []string   `json:"tags" binder:"dive,omitempty"`
+	//       Matrix [][]string `json:"matrix" binder:"dive,dive,required"`
+	//   }
 	GetFieldName func(reflect.StructField) (name, arg string)
 
 	// Hook is used to intercept the binding operation if set.
@@ -95,18 +272,486 @@ type Binder struct {
 	//
 	// Default: nil
 	Hook Hook
+
+	// PathHook is the same as Hook, but also receives the dotted path
+	// of the value to be bound, such as "secrets.password", which is empty
+	// for the top value.
+	//
+	// If both PathHook and Hook are set, PathHook is called first.
+	//
+	// Default: nil
+	PathHook PathHook
+
+	// ContextHook is the same as PathHook, but also receives the context
+	// passed to BindContext, such as the deadline and request-scoped values,
+	// which is context.Background() for Bind.
+	//
+	// If ContextHook, PathHook and Hook are set, they are called in turn.
+	//
+	// Default: nil
+	ContextHook func(ctx context.Context, path string, dst reflect.Value, src interface{}) (newsrc interface{}, err error)
+
+	// If true, merge the source map into the destination map if it is not nil,
+	// that's, insert the new keys and overwrite the existing keys without
+	// dropping the untouched keys, and the nested maps are merged recursively.
+	// Or, replace the destination map with a new one.
+	//
+	// Default: false
+	MergeMaps bool
+
+	// If true, append the elements bound from the source slice to the
+	// destination slice if it is not empty. Or, replace it with a new one.
+	// But it does not affect the array.
+	//
+	// Notice: if ZeroFields is true, the slice field is reset before binding,
+	// so the elements are appended to the empty slice, that's, replaced.
+	//
+	// Default: false
+	AppendSlices bool
+
+	// If true, expand the bracketed keys of the source url.Values
+	// or map[string][]string, such as "user[name]" and "tags[0]",
+	// into the nested map before binding. See ExpandBracketKeys.
+	//
+	// Default: false
+	BracketKeys bool
+
+	// FlattenSeparator is the separator of the flat keys of the source map,
+	// such as ".", which are expanded into the nested map before binding
+	// if set, for example, {"db.host": "localhost"} to {"db": {"host": "localhost"}}.
+	//
+	// If a key is used as both the scalar and the nested key,
+	// such as "db" and "db.host", return an error.
+	//
+	// Default: ""
+	FlattenSeparator string
+
+	// ExplodeSeparator is the separator to split the string source
+	// for the slice/array field with the field argument "explode=false".
+	//
+	// If empty, use ",".
+	//
+	// Default: ","
+	ExplodeSeparator string
+
+	// Converters is used to convert the source value to the destination value
+	// by the pair of their types, which is consulted after Hook and before
+	// the interfaces Unmarshaler and Setter, so it takes precedence over them.
+	//
+	// The converted value must be assignable to the destination value.
+	//
+	// Default: nil
+	Converters map[ConvertKey]func(src interface{}) (interface{}, error)
+
+	// InterfaceFactories is used to instantiate the concrete value
+	// for the non-empty interface type, such as the polymorphic payload,
+	// which picks the concrete type by the source, allocates and returns it,
+	// such as reflect.New(concreteType). Then, the source is bound to it,
+	// and the interface is set to it, or the element of it if the pointer
+	// does not implement the interface.
+	//
+	// If the returned value is invalid, the interface is bound as usual.
+	// See DiscriminatorFactory, which picks the concrete type
+	// by the discriminator key, such as "type", in the source map.
+	//
+	// Default: nil
+	InterfaceFactories map[reflect.Type]InterfaceFactory
+
+	// AfterField is called after the struct field has been bound successfully
+	// if set, which may be used to audit the field or compute the derived
+	// field, such as trimming the string or normalizing the enum centrally.
+	//
+	// If returning an error, abort binding.
+	//
+	// Default: nil
+	AfterField func(dst reflect.Value, field reflect.StructField, src interface{}) error
+
+	// FieldValidator is used to validate the struct field value
+	// immediately after it is bound and AfterField is called, so that
+	// the invalid value aborts binding before the rest fields are bound.
+	// The returned error is wrapped as *BindError with the field path.
+	//
+	// Notice: it is called only for the field found in the source.
+	//
+	// Default: nil
+	FieldValidator func(field reflect.StructField, value reflect.Value) error
+
+	// OnField is called for each struct field visited during binding,
+	// with the dotted path of the field and whether the source value
+	// is found, which is purely observational, such as logging or
+	// documentation.
+	//
+	// Notice: it is not called for the squashed struct field,
+	// but for the fields of the squashed struct.
+	//
+	// Default: nil
+	OnField func(path string, field reflect.StructField, matched bool)
+
+	// FieldResolver is used to look up the source value of the struct field
+	// from the source map if set, which gives the full control of the lookup,
+	// such as the fuzzy matching or the computed key.
+	//
+	// If found is false, the field is not bound.
+	// If nil, look up the source value by the field name.
+	//
+	// Default: nil
+	FieldResolver func(sf reflect.StructField, src map[string]interface{}) (value interface{}, found bool)
+
+	// NilStrings is a set of the literal strings, such as "undefined",
+	// which are treated as null when the source is one of them.
+	//
+	// For the pointer, interface, slice and map values, they are set to nil.
+	// For the bool and numeric values, an error is returned.
+	// For others, such as string, they are bound as the normal strings.
+	//
+	// Default: nil
+	NilStrings []string
+
+	// NullStrings is a set of the literal strings, such as "null", "NULL"
+	// and "nil", which represent the missing values, such as in CSV.
+	//
+	// When the string source is one of them, the pointer, interface,
+	// slice and map values are set to nil, and others are set to zero.
+	// Unlike NilStrings, no error is returned for any value.
+	//
+	// Default: nil
+	NullStrings []string
+
+	// If true, match NullStrings case-insensitively.
+	//
+	// Default: false
+	NullStringsFold bool
+
+	// If true, set the pointer, interface, slice and map struct fields
+	// to nil when the key of the field is present in the source map
+	// but its value is nil, such as the JSON null, which is used to
+	// distinguish "explicitly set to null" from "absent" like PATCH.
+	// The field whose key is absent is left untouched.
+	//
+	// Or, the nil source value is ignored like the absent key.
+	//
+	// Default: false
+	NullAsNil bool
+
+	// If true, reset the struct field to the zero value before binding
+	// the source value to it, which is applied to the fields at every
+	// nesting level and ensures that no stale data, such as the elements
+	// of map or slice, is left.
+	//
+	// Default: false
+	ZeroFields bool
+
+	// If true, look up the source value of the struct field from the source
+	// map case-insensitively when the exact field name is missing.
+	//
+	// If there are more than one keys that differ only by case, such as
+	// "UserName" and "username", the smallest one in lexicographic order,
+	// that's "UserName", is used.
+	//
+	// Default: false
+	CaseInsensitive bool
+
+	// If true, return an error when the length of the source is not equal
+	// to that of the array value, such as [4]byte.
+	//
+	// Or, only the leading elements fitting in the array are bound,
+	// and the extra source elements are dropped, which is recorded as
+	// a lossy Coercion by BindWithCoercions.
+	//
+	// Default: false
+	ArrayStrictLength bool
+
+	// DurationUnit is the unit of the plain number, including the numeric
+	// string such as "30", bound to time.Duration, such as time.Second.
+	// The duration string, such as "1s", is unaffected.
+	//
+	// If 0, the integer is interpreted as milliseconds,
+	// and the float is interpreted as seconds.
+	//
+	// Default: 0
+	DurationUnit time.Duration
+
+	// BoolTrueValues and BoolFalseValues are the extra string literals
+	// bound to bool as true and false case-insensitively, which are
+	// checked before the built-in literals, such as "1", "t" and "true".
+	//
+	// For example, BoolTrueValues is []string{"on", "yes", "y"} and
+	// BoolFalseValues is []string{"off", "no", "n"} for the HTML form.
+	//
+	// Default: nil
+	BoolTrueValues  []string
+	BoolFalseValues []string
+
+	// If true, set the bool and numeric values to the zero value
+	// when the source is an empty or whitespace-only string, such as
+	// the empty optional number input of the HTML form, even in Strict mode.
+	//
+	// Or, the empty string is converted to the zero value by default,
+	// but the whitespace-only string returns an error.
+	//
+	// Default: false
+	EmptyStringAsZero bool
+
+	// If true, trim the leading and trailing whitespaces of the string
+	// source before binding it to any value, such as " 12 " to int,
+	// which also applies to the elements of slice/array and the values
+	// of map, but not the keys of map.
+	//
+	// Default: false
+	TrimSpace bool
+
+	// If true, bind the struct from the slice or array source by index,
+	// that's, the exported and not ignored fields are bound in turn
+	// from the elements, such as a row of CSV.
+	//
+	// Or, return an error for the non-map source.
+	//
+	// Default: false
+	SliceToStruct bool
+
+	// If true, bind the source weakly like WeaklyTypedInput of mapstructure,
+	// which enables the coercions as follow:
+	//   - bool to string, that's, true to "1" and false to "0".
+	//   - single value to slice/array, that's, ConvertSingleToSlice,
+	//     such as "a" to []string{"a"}.
+	//   - slice/array to single value, that's, ConvertSliceToSingle,
+	//     such as []string{"a"} to "a".
+	//   - empty slice/array to empty map.
+	//
+	// Notice: the coercions as follow are always enabled:
+	//   - empty string to the zero value of number and bool.
+	//   - number to string, such as 123 to "123".
+	//   - number to bool, such as 0 to false, and others to true.
+	//   - string to bool, such as "1", "t" and "true" to true.
+	//
+	// Default: false
+	WeaklyTyped bool
+
+	// If true, deep-copy the source which is assignable to the value
+	// and contains the reference types, such as slice, map and pointer,
+	// so that the bound value does not share the underlying data with
+	// the source. The scalar and value types are unaffected.
+	//
+	// Notice: the source must not contain a cycle.
+	//
+	// Default: false
+	CopyAssignable bool
+
+	// If true, disable sql.Scanner, that's, not to call its Scan method
+	// to bind the destination value implementing it.
+	//
+	// Or, call Scan when the source is a valid driver.Value, such as string,
+	// []byte, int64, float64, bool and time.Time, or can be converted to it.
+	//
+	// Default: false
+	DisableScanner bool
+
+	// If true, ignore the interfaces Unmarshaler and Setter, that's,
+	// not to call their methods to bind the destination value implementing
+	// them, and bind it by the kind instead, such as the struct by fields.
+	//
+	// Default: false
+	IgnoreSetterUnmarshaler bool
+
+	// If true, prefer the interface Setter to Unmarshaler when the value
+	// implements both of them.
+	//
+	// Or, Unmarshaler takes precedence over Setter by default.
+	//
+	// Default: false
+	PreferSetter bool
+
+	// If true, bind time.Time from the map source containing the date and
+	// time parts, the keys of which are "year", "month", "day", "hour",
+	// "minute", "second" and "nanosecond", by time.Date in TimeLocation.
+	// The missing year, month and day default to 1, and others to 0.
+	// But if none of the parts is present, return an error, and if Strict
+	// is true, return an error for the unknown key.
+	//
+	// It does not affect the string and integer source, which is still
+	// parsed as the time string and the unix timestamp.
+	//
+	// Default: false
+	TimeFromParts bool
+
+	// TimeLocation is the location of time.Time bound by TimeFromParts,
+	// or parsed from the string source by time.ParseInLocation with
+	// the layout of TimeFormatTag or defaults.TimeFormats, which is used
+	// to interpret the time string without the zone, such as the local time
+	// submitted by the HTML form. The time from the unix timestamp is also
+	// converted to it.
+	//
+	// Notice: for the time string carrying the offset, such as RFC3339,
+	// the location is ignored and the offset in the string is used.
+	//
+	// If nil, use defaults.TimeLocation.
+	//
+	// Default: nil
+	TimeLocation *time.Location
+
+	// TimeFormats is the extra layouts to parse the string source
+	// to time.Time, which are tried in turn only after failing to parse it
+	// by defaults.TimeFormats, that's, time.RFC3339Nano, which also accepts
+	// time.RFC3339 without the fractional second, and "2006-01-02 15:04:05"
+	// by default.
+	//
+	// If empty, use the date-only layout time.DateOnly, that's, "2006-01-02".
+	//
+	// Default: nil
+	TimeFormats []string
+
+	// TimeFormatTag is the tag of the struct field to get the layout
+	// to parse the string source to the time.Time field, or the pointer,
+	// slice and array of it, such as
+	//   Created time.Time `json:"created" timeformat:"2006-01-02"`
+	//
+	// If the tag is absent, parse the string source by defaults.ToTime,
+	// which tries the global defaults.TimeFormats.
+	// If "-", disable the per-field layout.
+	//
+	// Default: "timeformat"
+	TimeFormatTag string
+
+	// If true, disable the built-in handling of time.Duration and time.Time,
+	// that's, time.Duration is bound as the normal int64, and time.Time is
+	// bound only by encoding.TextUnmarshaler, that's, the RFC3339 string.
+	//
+	// The precedence to bind a value is:
+	//   1. Hook
+	//   2. Converters, and the enum names registered by RegisterEnum
+	//   3. Unmarshaler, then Setter, or reversed by PreferSetter, if not ignored
+	//   4. the assignable source
+	//   5. sql.Scanner, such as sql.NullString, if not disabled
+	//   6. encoding.TextUnmarshaler, except time.Time if not disabled
+	//   7. the built-in handling of time.Duration and time.Time if not disabled
+	//   8. the built-in handling by the kind, such as url.URL and net.IPNet from the string
+	//
+	// Default: false
+	DisableTimeSpecialCase bool
+
+	// MaxDepth is the maximum nesting depth of the struct fields,
+	// the slice/array elements and the map values to be bound,
+	// which is used to protect from the maliciously deep nested source.
+	// If exceeded, return the error ErrMaxDepth.
+	//
+	// 0 means no limit.
+	//
+	// Default: 0
+	MaxDepth int
+
+	// If true, go on binding the remaining struct fields when failing
+	// to bind a field, and return the combined error of all the failed
+	// fields by errors.Join, each of which is a *BindError with the path.
+	//
+	// Default: false
+	CollectErrors bool
+
+	// If true, allow the integer source to overflow the integer value,
+	// such as 70000 to int16, which will wrap around silently.
+	// Or, return an error.
+	//
+	// Default: false
+	AllowOverflow bool
+
+	// If true, disable the coercion between the different kind families
+	// for bool, numeric and string values, that's, only allow
+	//   - bool    => bool
+	//   - numeric => int, uint and float, such as int to float64
+	//   - string  => string
+	// Or, return an error.
+	//
+	// Notice: it does not affect time.Duration and time.Time, which can be
+	// still bound from the numeric and string values as the canonical forms,
+	// nor []byte, which can be still bound from the string value as the raw
+	// bytes, nor the interfaces Unmarshaler, Setter and encoding.TextUnmarshaler.
+	//
+	// Default: false
+	Strict bool
 }
 
-// NewBinder returns a default binder.
-func NewBinder() Binder { return NewBinderWithHook(nil) }
+var (
+	// ErrConvert is the class of the errors failing to convert the source
+	// to the destination type, such as "abc" to int, or overflow.
+	ErrConvert = errors.New("conversion error")
 
-// NewBinderWithHook returns a default binder with the hook.
-func NewBinderWithHook(hook Hook) Binder {
-	return Binder{
+	// ErrUnsupported is the class of the errors that the destination type
+	// is not supported, such as chan and func.
+	ErrUnsupported = errors.New("unsupported type")
+
+	// ErrMaxDepth is returned when the nesting depth exceeds Binder.MaxDepth.
+	ErrMaxDepth = errors.New("max bind depth exceeded")
+)
+
+// sentinelError classifies the error as the sentinel error
+// for errors.Is without changing the error message.
+type sentinelError struct {
+	sentinel error
+	err      error
+}
+
+func newSentinelError(sentinel, err error) error {
+	if err == nil {
+		return nil
+	}
+
+	var se sentinelError
+	if errors.As(err, &se) && se.sentinel == sentinel {
+		return err
+	}
+	return sentinelError{sentinel: sentinel, err: err}
+}
+
+func newConvertError(err error) error { return newSentinelError(ErrConvert, err) }
+
+func (e sentinelError) Error() string   { return e.err.Error() }
+func (e sentinelError) Unwrap() []error { return []error{e.sentinel, e.err} }
+
+// NewBinder returns a default binder customized by the options.
+//
+// By default, ConvertSliceToSingle and ConvertSingleToSlice are true.
+func NewBinder(opts ...Option) Binder {
+	b := Binder{
 		ConvertSliceToSingle: true,
 		ConvertSingleToSlice: true,
-		Hook:                 hook,
 	}
+	return b.With(opts...)
+}
+
+// NewBinderWithHook returns a default binder with the hook.
+func NewBinderWithHook(hook Hook) Binder { return NewBinder(WithHook(hook)) }
+
+// Clone returns a deep copy of the binder, the slice and map fields
+// of which, such as Tags and Converters, are copied, so the clone
+// can be mutated independently.
+func (b Binder) Clone() Binder {
+	b.Tags = cloneStrings(b.Tags)
+	b.NilStrings = cloneStrings(b.NilStrings)
+	b.NullStrings = cloneStrings(b.NullStrings)
+	b.TimeFormats = cloneStrings(b.TimeFormats)
+	b.BoolTrueValues = cloneStrings(b.BoolTrueValues)
+	b.BoolFalseValues = cloneStrings(b.BoolFalseValues)
+	if b.Converters != nil {
+		converters := make(map[ConvertKey]func(interface{}) (interface{}, error), len(b.Converters))
+		for key, convert := range b.Converters {
+			converters[key] = convert
+		}
+		b.Converters = converters
+	}
+	if b.InterfaceFactories != nil {
+		factories := make(map[reflect.Type]InterfaceFactory, len(b.InterfaceFactories))
+		for itype, factory := range b.InterfaceFactories {
+			factories[itype] = factory
+		}
+		b.InterfaceFactories = factories
+	}
+	return b
+}
+
+func cloneStrings(ss []string) []string {
+	if ss == nil {
+		return nil
+	}
+	return append(make([]string, 0, len(ss)), ss...)
 }
 
 // Bind is used to bind the value dstptr to src.
@@ -138,104 +783,642 @@ func NewBinderWithHook(hook Hook) Binder {
 //   - time.Duration
 //   - Struct
 //
-// And any pointer to the types above, and the interfaces Unmarshaler and Setter.
+// And any pointer to the types above, and the interfaces Unmarshaler, Setter
+// and encoding.TextUnmarshaler, the last of which is only used for the string
+// or []byte source.
 func (b Binder) Bind(dstptr, src interface{}) error {
-	return binder{b.fieldNameGetter(), b}.Bind(dstptr, src)
+	return b.BindContext(context.Background(), dstptr, src)
 }
 
-func (b Binder) fieldNameGetter() func(reflect.StructField) (string, string) {
-	if b.GetFieldName != nil {
-		return b.GetFieldName
-	}
-	return defaults.GetStructFieldName
+// BindContext is the same as Bind, but carries the context, which is
+// passed to ContextHook, and stops binding the rest struct fields
+// with the error of the context when it is done.
+func (b Binder) BindContext(ctx context.Context, dstptr, src interface{}) error {
+	binder := b.newBinder()
+	binder.ctx = ctx
+	return binder.Bind(dstptr, src)
 }
 
-type binder struct {
-	getFieldName func(reflect.StructField) (name, arg string)
-	Binder
+// BindError represents an error occurred when binding a value.
+type BindError struct {
+	Path   string       // The path of the bound value, such as "structs[1].query".
+	Kind   reflect.Kind // The kind of the bound value.
+	Source interface{}  // The source value.
+	Err    error        // The underlying error.
 }
 
-func (b binder) Bind(dst, src interface{}) error {
-	dstValue, ok := dst.(reflect.Value)
-	if !ok {
-		dstValue = reflect.ValueOf(dst)
+// Error implements the interface error.
+func (e *BindError) Error() string {
+	if e.Path == "" {
+		return e.Err.Error()
 	}
+	return fmt.Sprintf("path %q: %s", e.Path, e.Err.Error())
+}
 
-	switch {
-	case dstValue.CanSet():
-	case dstValue.Kind() == reflect.Pointer:
-		if dstValue = dstValue.Elem(); !dstValue.CanSet() {
-			return fmt.Errorf("%T must be a pointer to a value that can be set", dst)
-		}
-	default:
-		return fmt.Errorf("Binder.Bind: %T must be canset or a pointer", dst)
-	}
+// Unwrap returns the underlying error.
+func (e *BindError) Unwrap() error { return e.Err }
 
-	return b.bind(dstValue.Kind(), dstValue, src)
+// Coercion represents a type coercion performed during binding.
+type Coercion struct {
+	Path    string       // The path of the bound value, such as "structs[1].query".
+	SrcType reflect.Type // The type of the source value.
+	DstType reflect.Type // The type of the destination value.
+	Lossy   bool         // Whether the coercion loses the information, such as 1.5 => 1.
 }
 
-func (b binder) bind(kind reflect.Kind, value reflect.Value, src interface{}) (err error) {
-	if src == nil {
-		return
+// BindWithCoercions uses DefaultBinder to bind dstptr to src,
+// and returns the type coercions performed during binding.
+func BindWithCoercions(dstptr, src interface{}) ([]Coercion, error) {
+	return DefaultBinder.BindWithCoercions(dstptr, src)
+}
+
+// BindWithCoercions is the same as Bind, but also returns the type coercions
+// performed by the scalar bindings, which is used to audit or debug
+// the silently lossy conversions.
+func (b Binder) BindWithCoercions(dstptr, src interface{}) (coercions []Coercion, err error) {
+	binder := b.newBinder()
+	binder.coercions = &coercions
+	err = binder.Bind(dstptr, src)
+	return
+}
+
+func (b Binder) newBinder() binder {
+	if b.WeaklyTyped {
+		b.ConvertSliceToSingle = true
+		b.ConvertSingleToSlice = true
 	}
+	return binder{binderConfig: &binderConfig{
+		ctx:          context.Background(),
+		getFieldName: b.fieldNameGetter(),
+		Binder:       b,
+	}}
+}
 
-	if !value.CanSet() {
-		switch kind {
-		case reflect.Pointer, reflect.Interface:
-			if !value.Elem().CanAddr() {
-				return
-			}
-		default:
-			return
+func (b Binder) fieldNameGetter() func(reflect.StructField) (string, string) {
+	switch {
+	case b.GetFieldName != nil:
+		return b.GetFieldName
+	case b.TagName != "":
+		return assists.StructFieldNameFuncWithTags(b.TagName)
+	case len(b.Tags) > 0:
+		tags := b.Tags
+		return func(sf reflect.StructField) (string, string) {
+			return getStructFieldNameWithTags(sf, tags)
 		}
+	default:
+		return defaults.GetStructFieldName
 	}
+}
 
-	if b.Hook != nil {
-		src, err = b.Hook(value, src)
-		if err != nil || src == nil {
-			return err
+// getStructFieldNameWithTags returns the name and arg of the struct field
+// by the first tag whose name is not empty and not "-".
+func getStructFieldNameWithTags(sf reflect.StructField, tags []string) (name, arg string) {
+	var ignored bool
+	for _, tag := range tags {
+		if tag == "" {
+			continue
 		}
-	}
 
-	if b.ConvertSliceToSingle && kind != reflect.Array && kind != reflect.Slice {
-		switch srcValue := reflect.ValueOf(src); srcValue.Kind() {
-		case reflect.Slice, reflect.Array:
-			if srcValue.Len() == 0 {
-				return
+		value, _arg, ok := field.LookupTag(sf, tag)
+		switch {
+		case !ok:
+		case value == "-":
+			ignored = true
+		case value == "":
+			if arg == "" {
+				arg = _arg
 			}
-			src = srcValue.Index(0).Interface()
+		default:
+			return value, _arg
 		}
 	}
 
-	ptrvalue := value
-	if kind != reflect.Pointer {
-		ptrvalue = value.Addr()
-	}
-	switch t := ptrvalue.Interface().(type) {
-	case Unmarshaler:
-		return t.UnmarshalBind(src)
-	case Setter:
-		return t.Set(src)
+	if !ignored {
+		name = sf.Name
 	}
+	return
+}
 
-	if reflect.TypeOf(src).AssignableTo(value.Type()) {
-		value.Set(reflect.ValueOf(src))
-		return
-	}
+// binder is the state of binding a value, which is copied for each
+// nested value, so the shared configuration is referred by the pointer
+// to keep it small.
+type binder struct {
+	*binderConfig
+	path       string
+	depth      int
+	visited    []uintptr // The source containers being descended into.
+	prefix     string    // The key prefix of the squashed struct fields.
+	dive       []string  // The element options of the dive levels.
+	timeLayout string    // The time layout of the current field.
+	keepSpace  bool      // Do not trim the string source, such as the map keys.
+}
 
-	switch kind {
-	case reflect.Bool:
-		err = b.bindBool(value, src)
+// binderConfig is the configuration shared by all the nested binders.
+type binderConfig struct {
+	ctx          context.Context
+	getFieldName func(reflect.StructField) (name, arg string)
+	coercions    *[]Coercion
+	Binder
+}
+
+// withField returns a new binder with the path appending the field name.
+func (b binder) withField(name string) binder {
+	if b.path == "" {
+		b.path = name
+	} else {
+		b.path = b.path + "." + name
+	}
+	b.prefix = ""
+	b.dive = nil
+	b.timeLayout = ""
+	b.depth++
+	return b
+}
+
+// withSquashPrefix returns a new binder with the key prefix appending
+// the prefix of the field argument like "squash=shipping_", which is
+// used to look up the fields of the squashed struct from the source.
+func (b binder) withSquashPrefix(arg string) binder {
+	b.prefix += getSquashPrefix(arg)
+	return b
+}
+
+// getSquashPrefix returns the prefix of the field argument
+// "squash=prefix" or "inline=prefix".
+func getSquashPrefix(arg string) string {
+	prefix, ok := lookupFieldArg(arg, "squash")
+	if !ok {
+		prefix, _ = lookupFieldArg(arg, "inline")
+	}
+	return prefix
+}
+
+// getDiveOptions returns the options of the field and the elements of its
+// dive levels from the tag "binder", which are separated by "dive", such as
+//
+//	`binder:"dive,required"`           // required for the elements
+//	`binder:"required,dive,omitempty"` // required for the field, omitempty for the elements
+//	`binder:"dive,dive,required"`      // required for the elements of the elements
+//
+// For the elements, the options are applied to the slice and array,
+// and the supported options are "required" and "omitempty".
+func getDiveOptions(sf reflect.StructField) (field string, elems []string) {
+	tag := sf.Tag.Get("binder")
+	if tag == "" {
+		return
+	}
+
+	var opts []string
+	var dived bool
+	for _, opt := range strings.Split(tag, ",") {
+		if opt = strings.TrimSpace(opt); opt != "dive" {
+			opts = append(opts, opt)
+			continue
+		}
+
+		if dived {
+			elems = append(elems, strings.Join(opts, ","))
+		} else {
+			field, dived = strings.Join(opts, ","), true
+		}
+		opts = opts[:0]
+	}
+
+	if dived {
+		elems = append(elems, strings.Join(opts, ","))
+	} else {
+		field = strings.Join(opts, ",")
+	}
+	return
+}
+
+// isSquashed reports whether the field argument contains "squash"
+// or its alias "inline".
+func isSquashed(arg string) bool {
+	return hasFieldArg(arg, "squash") || hasFieldArg(arg, "inline")
+}
+
+// withIndex returns a new binder with the path appending the element index.
+func (b binder) withIndex(index int) binder {
+	b.path = b.path + "[" + strconv.Itoa(index) + "]"
+	b.prefix = ""
+	b.dive = nil
+	b.depth++
+	return b
+}
+
+// wrapError wraps the error err as *BindError with the current path
+// if it is not a *BindError.
+func (b binder) wrapError(kind reflect.Kind, src interface{}, err error) error {
+	var be *BindError
+	if errors.As(err, &be) {
+		return err
+	}
+	return &BindError{Path: b.path, Kind: kind, Source: src, Err: err}
+}
+
+// deepCopy returns a deep copy of v, which copies the elements
+// of slice, array and map, the values referred by pointer and interface,
+// and the exported fields of struct recursively.
+func deepCopy(v reflect.Value) reflect.Value {
+	switch v.Kind() {
+	case reflect.Slice:
+		if v.IsNil() {
+			return v
+		}
+
+		c := reflect.MakeSlice(v.Type(), v.Len(), v.Len())
+		for i, _len := 0, v.Len(); i < _len; i++ {
+			c.Index(i).Set(deepCopy(v.Index(i)))
+		}
+		return c
+
+	case reflect.Array:
+		c := reflect.New(v.Type()).Elem()
+		for i, _len := 0, v.Len(); i < _len; i++ {
+			c.Index(i).Set(deepCopy(v.Index(i)))
+		}
+		return c
+
+	case reflect.Map:
+		if v.IsNil() {
+			return v
+		}
+
+		c := reflect.MakeMapWithSize(v.Type(), v.Len())
+		for iter := v.MapRange(); iter.Next(); {
+			c.SetMapIndex(iter.Key(), deepCopy(iter.Value()))
+		}
+		return c
+
+	case reflect.Pointer:
+		if v.IsNil() {
+			return v
+		}
+
+		c := reflect.New(v.Type().Elem())
+		c.Elem().Set(deepCopy(v.Elem()))
+		return c
+
+	case reflect.Interface:
+		if v.IsNil() {
+			return v
+		}
+
+		c := reflect.New(v.Type()).Elem()
+		c.Set(deepCopy(v.Elem()))
+		return c
+
+	case reflect.Struct:
+		c := reflect.New(v.Type()).Elem()
+		c.Set(v)
+		for i, num := 0, v.NumField(); i < num; i++ {
+			if field := c.Field(i); field.CanSet() {
+				field.Set(deepCopy(v.Field(i)))
+			}
+		}
+		return c
+
+	default:
+		return v
+	}
+}
+
+// visit returns a new binder recording the source container, such as map,
+// slice or pointer, which is being descended into, and returns an error
+// if it has been visited by an ancestor, that's, the source has a cycle.
+//
+// The source of the value types, such as struct and array, can't cycle,
+// so it is not recorded.
+func (b binder) visit(src interface{}) (binder, error) {
+	srcValue := reflect.ValueOf(src)
+	switch srcValue.Kind() {
+	case reflect.Map, reflect.Pointer, reflect.Slice:
+		if srcValue.IsNil() || (srcValue.Kind() == reflect.Slice && srcValue.Len() == 0) {
+			return b, nil
+		}
+	default:
+		return b, nil
+	}
+
+	ptr := srcValue.Pointer()
+	for _, p := range b.visited {
+		if p == ptr {
+			return b, errors.New("cycle detected in the source")
+		}
+	}
+
+	// Copy on append to avoid affecting the siblings sharing the same ancestors.
+	b.visited = append(b.visited[:len(b.visited):len(b.visited)], ptr)
+	return b, nil
+}
+
+// addCoercion records the coercion from src to dst if collecting coercions.
+func (b binder) addCoercion(dst reflect.Value, src interface{}, lossy bool) {
+	if b.coercions == nil {
+		return
+	}
+
+	srcType := reflect.TypeOf(src)
+	if srcType == dst.Type() {
+		return
+	}
+
+	*b.coercions = append(*b.coercions, Coercion{
+		Path:    b.path,
+		SrcType: srcType,
+		DstType: dst.Type(),
+		Lossy:   lossy,
+	})
+}
+
+func (b binder) Bind(dst, src interface{}) (err error) {
+	dstValue, ok := dst.(reflect.Value)
+	if !ok {
+		dstValue = reflect.ValueOf(dst)
+	}
+
+	switch {
+	case dstValue.CanSet():
+	case dstValue.Kind() == reflect.Pointer:
+		if dstValue = dstValue.Elem(); !dstValue.CanSet() {
+			return fmt.Errorf("%T must be a pointer to a value that can be set", dst)
+		}
+	default:
+		return fmt.Errorf("Binder.Bind: %T must be canset or a pointer", dst)
+	}
+
+	if b.BracketKeys {
+		switch values := src.(type) {
+		case url.Values:
+			src, err = ExpandBracketKeys(values)
+		case map[string][]string:
+			src, err = ExpandBracketKeys(values)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	if b.FlattenSeparator != "" {
+		if src, err = expandFlattenKeys(src, b.FlattenSeparator); err != nil {
+			return err
+		}
+	}
+
+	return b.bind(dstValue.Kind(), dstValue, src)
+}
+
+// expandFlattenKeys expands the flat keys, such as "db.host" separated by sep,
+// of the source map with the string key into the nested map.
+func expandFlattenKeys(src interface{}, sep string) (interface{}, error) {
+	srcValue := reflect.ValueOf(src)
+	if srcValue.Kind() != reflect.Map || srcValue.Type().Key().Kind() != reflect.String {
+		return src, nil
+	}
+
+	keys := make([]string, 0, srcValue.Len())
+	for iter := srcValue.MapRange(); iter.Next(); {
+		keys = append(keys, iter.Key().String())
+	}
+	sort.Strings(keys) // Make the collision error deterministic.
+
+	// The nested maps created or copied here, which can be modified.
+	// The nested map of the source is copied before being merged into,
+	// so that the source is not modified.
+	owned := make(map[uintptr]struct{}, 4)
+
+	maps := make(map[string]interface{}, len(keys))
+	for _, key := range keys {
+		value := srcValue.MapIndex(reflect.ValueOf(key).Convert(srcValue.Type().Key())).Interface()
+		parts := strings.Split(key, sep)
+
+		current := maps
+		for i, last := 0, len(parts)-1; i < last; i++ {
+			switch v := current[parts[i]].(type) {
+			case nil:
+				submaps := make(map[string]interface{}, 4)
+				owned[reflect.ValueOf(submaps).Pointer()] = struct{}{}
+				current[parts[i]] = submaps
+				current = submaps
+			case map[string]interface{}:
+				if _, ok := owned[reflect.ValueOf(v).Pointer()]; !ok {
+					submaps := make(map[string]interface{}, len(v)+4)
+					for k, v := range v {
+						submaps[k] = v
+					}
+					owned[reflect.ValueOf(submaps).Pointer()] = struct{}{}
+					current[parts[i]] = submaps
+					v = submaps
+				}
+				current = v
+			default:
+				return nil, fmt.Errorf("key '%s' collides with the scalar key '%s'",
+					key, strings.Join(parts[:i+1], sep))
+			}
+		}
+
+		last := parts[len(parts)-1]
+		if _, ok := current[last]; ok {
+			return nil, fmt.Errorf("key '%s' collides with the nested keys", key)
+		}
+		current[last] = value
+	}
+
+	return maps, nil
+}
+
+func (b binder) bind(kind reflect.Kind, value reflect.Value, src interface{}) (err error) {
+	if src == nil {
+		return
+	}
+
+	// Wrap the error without defer, which is called for each nested value.
+	if src, err = b.bindValue(kind, value, src); err != nil {
+		err = b.wrapError(kind, src, err)
+	}
+	return
+}
+
+// bindValue binds value to src, and returns the source value converted
+// by the hooks, etc, which is recorded in the returned error.
+func (b binder) bindValue(kind reflect.Kind, value reflect.Value, src interface{}) (_ interface{}, err error) {
+	if b.MaxDepth > 0 && b.depth > b.MaxDepth {
+		return src, ErrMaxDepth
+	}
+
+	if !value.CanSet() {
+		switch kind {
+		case reflect.Pointer, reflect.Interface:
+			if !value.Elem().CanAddr() {
+				return src, err
+			}
+		default:
+			return src, err
+		}
+	}
+
+	if b.ContextHook != nil {
+		src, err = b.ContextHook(b.ctx, b.path, value, src)
+		if err != nil || src == nil {
+			return src, err
+		}
+	}
+
+	if b.PathHook != nil {
+		src, err = b.PathHook(b.path, value, src)
+		if err != nil || src == nil {
+			return src, err
+		}
+	}
+
+	if b.Hook != nil {
+		src, err = b.Hook(value, src)
+		if err != nil || src == nil {
+			return src, err
+		}
+	}
+
+	if b.ConvertSliceToSingle && kind != reflect.Array && kind != reflect.Slice && !b.isStructByIndex(kind, value) {
+		switch srcValue := reflect.ValueOf(src); srcValue.Kind() {
+		case reflect.Slice, reflect.Array:
+			if srcValue.Len() == 0 {
+				return src, err
+			} else if b.ErrorOnMultiToSingle && srcValue.Len() > 1 {
+				return src, newConvertError(fmt.Errorf("cannot bind %d values to the single %s",
+					srcValue.Len(), value.Type().String()))
+			}
+			src = srcValue.Index(b.singleIndex(srcValue.Len())).Interface()
+		}
+	}
+
+	if s, ok := src.(string); ok && b.TrimSpace && !b.keepSpace {
+		src = strings.TrimSpace(s)
+	}
+
+	if s, ok := src.(string); ok && b.EmptyStringAsZero && strings.TrimSpace(s) == "" {
+		switch kind {
+		case reflect.Bool, reflect.Float32, reflect.Float64,
+			reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+			reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+			value.Set(reflect.Zero(value.Type()))
+			return src, err
+		}
+	}
+
+	if s, ok := src.(string); ok && b.isNullString(s) {
+		if value.CanSet() {
+			value.Set(reflect.Zero(value.Type()))
+		}
+		return src, err
+	}
+
+	if s, ok := src.(string); ok && b.isNilString(s) {
+		switch kind {
+		case reflect.Pointer, reflect.Interface, reflect.Slice, reflect.Map:
+			if value.CanSet() {
+				value.Set(reflect.Zero(value.Type()))
+			}
+			return src, err
+
+		case reflect.Bool, reflect.Float32, reflect.Float64,
+			reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+			reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+			return src, newConvertError(fmt.Errorf("cannot bind the nil string '%s' to %s", s, value.Type().String()))
+		}
+	}
+
+	if len(b.Converters) > 0 {
+		key := ConvertKey{From: reflect.TypeOf(src), To: value.Type()}
+		if convert, ok := b.Converters[key]; ok {
+			return src, b.setConvertedValue(value, convert, src)
+		}
+	}
+
+	if name, ok := src.(string); ok && kind == reflect.Int {
+		if parse := lookupEnum(value.Type()); parse != nil {
+			var v reflect.Value
+			if v, err = parse(name); err != nil {
+				return src, newConvertError(err)
+			}
+			value.Set(v)
+			return src, err
+		}
+	}
+
+	ptrvalue := value
+	if kind != reflect.Pointer {
+		ptrvalue = value.Addr()
+	}
+	if !b.IgnoreSetterUnmarshaler {
+		if b.PreferSetter {
+			if t, ok := ptrvalue.Interface().(Setter); ok {
+				return src, t.Set(src)
+			}
+		}
+
+		switch t := ptrvalue.Interface().(type) {
+		case Unmarshaler:
+			return src, t.UnmarshalBind(src)
+		case Setter:
+			return src, t.Set(src)
+		}
+	}
+
+	if reflect.TypeOf(src).AssignableTo(value.Type()) && !b.isMergedContainer(kind, value) && len(b.dive) == 0 &&
+		!(b.TrimSpace && !b.keepSpace && isStringContainer(value.Type())) {
+		if b.CopyAssignable {
+			value.Set(deepCopy(reflect.ValueOf(src)))
+		} else {
+			value.Set(reflect.ValueOf(src))
+		}
+		return src, err
+	}
+
+	// Such as sql.NullString, sql.NullInt64, etc.
+	if kind != reflect.Pointer && !b.DisableScanner {
+		if scanner, ok := ptrvalue.Interface().(sql.Scanner); ok {
+			// Only scan the source which is a valid driver.Value,
+			// such as string, []byte, int64, float64, bool and time.Time,
+			// or can be converted to it, such as int.
+			if v, err := driver.DefaultParameterConverter.ConvertValue(src); err == nil {
+				return src, newConvertError(scanner.Scan(v))
+			}
+		}
+	}
+
+	// time.Time is parsed by defaults.ToTime, which supports more formats.
+	if kind != reflect.Pointer && (b.DisableTimeSpecialCase || value.Type() != timeType) {
+		if u, ok := ptrvalue.Interface().(encoding.TextUnmarshaler); ok {
+			switch v := src.(type) {
+			case string:
+				return src, u.UnmarshalText([]byte(v))
+			case []byte:
+				return src, u.UnmarshalText(v)
+			}
+		}
+	}
+
+	switch kind {
+	case reflect.Struct, reflect.Array, reflect.Slice, reflect.Map:
+		if b, err = b.visit(src); err != nil {
+			return src, err
+		}
+	}
+
+	switch kind {
+	case reflect.Bool:
+		err = newConvertError(b.bindBool(value, src))
 	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32:
-		err = b.bindInt(value, src)
+		err = newConvertError(b.bindInt(value, src))
 	case reflect.Int64:
-		err = b.bindInt64(value, src)
+		err = newConvertError(b.bindInt64(value, src))
 	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
-		err = b.bindUint(value, src)
+		err = newConvertError(b.bindUint(value, src))
 	case reflect.Float32, reflect.Float64:
-		err = b.bindFloat(value, src)
+		err = newConvertError(b.bindFloat(value, src))
 	case reflect.String:
-		err = b.bindString(value, src)
+		err = newConvertError(b.bindString(value, src))
 	case reflect.Pointer:
 		err = b.bindPointer(value, src)
 	case reflect.Interface:
@@ -255,300 +1438,1502 @@ func (b binder) bind(kind reflect.Kind, value reflect.Value, src interface{}) (e
 	// case reflect.Complex128:
 	// case reflect.UnsafePointer:
 	default:
-		err = fmt.Errorf("unsupport to bind %T to a value", value.Interface())
+		err = newSentinelError(ErrUnsupported, fmt.Errorf("unsupport to bind %T to a value", value.Interface()))
+	}
+
+	return src, err
+}
+
+func (b binder) setConvertedValue(value reflect.Value,
+	convert func(interface{}) (interface{}, error), src interface{}) (err error) {
+	v, err := convert(src)
+	if err != nil || v == nil {
+		return
+	}
+
+	newValue := reflect.ValueOf(v)
+	if !newValue.Type().AssignableTo(value.Type()) {
+		return fmt.Errorf("converter returns %T, which cannot be assigned to %s",
+			v, value.Type().String())
+	}
+
+	value.Set(newValue)
+	return
+}
+
+// singleIndex returns the index of the element by SliceToSingleIndex
+// in the slice with the length _len, which must be greater than 0.
+func (b binder) singleIndex(_len int) int {
+	index := b.SliceToSingleIndex
+	if index < 0 {
+		index += _len
+	}
+
+	switch {
+	case index < 0:
+		return 0
+	case index >= _len:
+		return _len - 1
+	default:
+		return index
+	}
+}
+
+func (b binder) isNullString(s string) bool {
+	if len(b.NullStrings) == 0 {
+		return false
+	} else if b.NullStringsFold {
+		return containsFold(b.NullStrings, s)
+	}
+
+	for _, ns := range b.NullStrings {
+		if s == ns {
+			return true
+		}
+	}
+	return false
+}
+
+func (b binder) isNilString(s string) bool {
+	for _, ns := range b.NilStrings {
+		if s == ns {
+			return true
+		}
+	}
+	return false
+}
+
+func (b binder) bindBool(dstValue reflect.Value, src interface{}) (err error) {
+	if err = b.checkStrict(dstValue, src); err != nil {
+		return
+	}
+
+	if s, ok := src.(string); ok {
+		if containsFold(b.BoolTrueValues, s) {
+			dstValue.SetBool(true)
+			b.addCoercion(dstValue, src, false)
+			return
+		} else if containsFold(b.BoolFalseValues, s) {
+			dstValue.SetBool(false)
+			b.addCoercion(dstValue, src, false)
+			return
+		}
+	}
+
+	v, err := defaults.ToBool(src)
+	if err == nil {
+		dstValue.SetBool(v)
+		b.addCoercion(dstValue, src, isLossyToBool(src))
+	}
+	return
+}
+
+func (b binder) bindInt(dstValue reflect.Value, src interface{}) (err error) {
+	if err = b.checkStrict(dstValue, src); err != nil {
+		return
+	}
+
+	if !b.AllowOverflow && floatOverflowsInt64(src, 1) {
+		return fmt.Errorf("value %v overflows %s", src, dstValue.Type().String())
+	}
+
+	v, err := defaults.ToInt64(src)
+	if err == nil && !b.AllowOverflow && dstValue.OverflowInt(v) {
+		err = fmt.Errorf("value %d overflows %s", v, dstValue.Type().String())
+	}
+	if err == nil {
+		dstValue.SetInt(v)
+		b.addCoercion(dstValue, src, hasFraction(src) || dstValue.Int() != v)
+	}
+	return
+}
+
+func (b binder) bindInt64(dstValue reflect.Value, src interface{}) (err error) {
+	if _, ok := dstValue.Interface().(time.Duration); !ok || b.DisableTimeSpecialCase {
+		return b.bindInt(dstValue, src)
+	}
+
+	// The float source is the number of DurationUnit, or seconds by default.
+	scale := time.Second
+	if b.DurationUnit > 0 {
+		scale = b.DurationUnit
+	}
+	if !b.AllowOverflow && floatOverflowsInt64(src, float64(scale)) {
+		return fmt.Errorf("value %v overflows %s", src, dstValue.Type().String())
+	}
+
+	if b.DurationUnit > 0 {
+		if v, overflow, ok := toDurationWithUnit(src, b.DurationUnit); ok {
+			if overflow && !b.AllowOverflow {
+				return fmt.Errorf("value %v overflows %s", src, dstValue.Type().String())
+			}
+			dstValue.SetInt(int64(v))
+			b.addCoercion(dstValue, src, false)
+			return
+		}
+	}
+
+	v, err := defaults.ToDuration(src)
+	if err != nil {
+		if s, ok := src.(string); ok {
+			err = fmt.Errorf("invalid duration '%s', which should be a number or like \"1h30m\": %w", s, err)
+		}
+		return
+	}
+
+	dstValue.SetInt(int64(v))
+	b.addCoercion(dstValue, src, false)
+	return
+}
+
+// toDurationWithUnit converts the numeric source, including the numeric
+// string, to time.Duration in the unit. If src is not numeric, return false.
+//
+// overflow reports whether the source scaled by the unit is out of the range
+// of time.Duration, in which case the returned duration is wrapped.
+func toDurationWithUnit(src interface{}, unit time.Duration) (d time.Duration, overflow, ok bool) {
+	if s, ok := src.(string); ok {
+		if i, err := strconv.ParseInt(s, 10, 64); err == nil {
+			return scaleIntDuration(i, unit)
+		} else if f, err := strconv.ParseFloat(s, 64); err == nil {
+			return scaleFloatDuration(f, unit)
+		}
+		return 0, false, false
+	}
+
+	switch v := reflect.ValueOf(src); v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return scaleIntDuration(v.Int(), unit)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		u := v.Uint()
+		return time.Duration(u) * unit, u > uint64(math.MaxInt64/unit), true
+	case reflect.Float32, reflect.Float64:
+		return scaleFloatDuration(v.Float(), unit)
+	default:
+		return 0, false, false
+	}
+}
+
+func scaleIntDuration(i int64, unit time.Duration) (time.Duration, bool, bool) {
+	max := int64(math.MaxInt64 / unit)
+	return time.Duration(i) * unit, i > max || i < -max, true
+}
+
+func scaleFloatDuration(f float64, unit time.Duration) (time.Duration, bool, bool) {
+	f *= float64(unit)
+	return time.Duration(f), math.IsNaN(f) || f < math.MinInt64 || f >= math.MaxInt64, true
+}
+
+func (b binder) bindUint(dstValue reflect.Value, src interface{}) (err error) {
+	if err = b.checkStrict(dstValue, src); err != nil {
+		return
+	}
+
+	if isNegative(src) {
+		return fmt.Errorf("cannot bind negative value %v to %s", src, dstValue.Type().String())
+	}
+
+	if !b.AllowOverflow && floatOverflowsUint64(src) {
+		return fmt.Errorf("value %v overflows %s", src, dstValue.Type().String())
+	}
+
+	// "-0" is not negative, but rejected by strconv.ParseUint.
+	var v uint64
+	if !isNegativeZero(src) {
+		if v, err = defaults.ToUint64(src); err != nil {
+			return
+		}
+	}
+
+	if !b.AllowOverflow && dstValue.OverflowUint(v) {
+		return fmt.Errorf("value %d overflows %s", v, dstValue.Type().String())
+	}
+
+	dstValue.SetUint(v)
+	b.addCoercion(dstValue, src, hasFraction(src) || dstValue.Uint() != v)
+	return
+}
+
+func (b binder) bindFloat(dstValue reflect.Value, src interface{}) (err error) {
+	if err = b.checkStrict(dstValue, src); err != nil {
+		return
+	}
+
+	v, err := defaults.ToFloat64(src)
+	if err == nil {
+		dstValue.SetFloat(v)
+		b.addCoercion(dstValue, src, isLossyToFloat(dstValue, src))
+	}
+	return
+}
+
+func (b binder) bindString(dstValue reflect.Value, src interface{}) (err error) {
+	if err = b.checkStrict(dstValue, src); err != nil {
+		return
+	}
+
+	if v, ok := src.(bool); ok && b.WeaklyTyped {
+		if v {
+			dstValue.SetString("1")
+		} else {
+			dstValue.SetString("0")
+		}
+		b.addCoercion(dstValue, src, false)
+		return
+	}
+
+	v, err := defaults.ToString(src)
+	if err == nil {
+		dstValue.SetString(v)
+		b.addCoercion(dstValue, src, false)
+	}
+	return
+}
+
+// containsString reports whether ss contains s.
+func containsString(ss []string, s string) bool {
+	for _, _s := range ss {
+		if _s == s {
+			return true
+		}
+	}
+	return false
+}
+
+// containsFold reports whether ss contains s case-insensitively.
+func containsFold(ss []string, s string) bool {
+	for _, _s := range ss {
+		if strings.EqualFold(_s, s) {
+			return true
+		}
+	}
+	return false
+}
+
+// checkStrict checks whether the kind family of src is the same as dstValue
+// if Strict is true.
+func (b binder) checkStrict(dstValue reflect.Value, src interface{}) error {
+	if b.Strict && kindFamily(reflect.TypeOf(src).Kind()) != kindFamily(dstValue.Kind()) {
+		return fmt.Errorf("cannot bind %T to %s in strict mode", src, dstValue.Type().String())
+	}
+	return nil
+}
+
+func kindFamily(kind reflect.Kind) int {
+	switch kind {
+	case reflect.Bool:
+		return 1
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64:
+		return 2
+	case reflect.String:
+		return 3
+	default:
+		return 0
+	}
+}
+
+// isNegativeZero reports whether src is the numeric string of the negative
+// zero, such as "-0" and "-0.0".
+func isNegativeZero(src interface{}) bool {
+	s, ok := src.(string)
+	if !ok {
+		return false
+	}
+
+	s = strings.TrimSpace(s)
+	f, err := strconv.ParseFloat(s, 64)
+	return err == nil && f == 0 && strings.HasPrefix(s, "-")
+}
+
+// isNegative reports whether src is a negative number or numeric string.
+func isNegative(src interface{}) bool {
+	switch v := reflect.ValueOf(src); v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return v.Int() < 0
+	case reflect.Float32, reflect.Float64:
+		return v.Float() < 0
+	case reflect.String:
+		// Parse the number, so that "-0" is not negative.
+		f, err := strconv.ParseFloat(strings.TrimSpace(v.String()), 64)
+		return err == nil && f < 0
+	}
+	return false
+}
+
+// floatOverflowsInt64 reports whether the float source multiplied by scale
+// is out of the range of int64, which is wrapped silently by the conversion.
+func floatOverflowsInt64(src interface{}, scale float64) bool {
+	switch v := reflect.ValueOf(src); v.Kind() {
+	case reflect.Float32, reflect.Float64:
+		f := v.Float() * scale
+		return math.IsNaN(f) || f < math.MinInt64 || f >= math.MaxInt64
+	}
+	return false
+}
+
+// floatOverflowsUint64 reports whether the float source is out of the range
+// of uint64, which is wrapped silently by the conversion.
+func floatOverflowsUint64(src interface{}) bool {
+	switch v := reflect.ValueOf(src); v.Kind() {
+	case reflect.Float32, reflect.Float64:
+		f := v.Float()
+		return math.IsNaN(f) || f >= math.MaxUint64
+	}
+	return false
+}
+
+// hasFraction reports whether src is a float with the fractional part.
+func hasFraction(src interface{}) bool {
+	switch v := reflect.ValueOf(src); v.Kind() {
+	case reflect.Float32, reflect.Float64:
+		f := v.Float()
+		return f != math.Trunc(f)
+	}
+	return false
+}
+
+// isLossyToBool reports whether src is a number other than 0 and 1.
+func isLossyToBool(src interface{}) bool {
+	switch v := reflect.ValueOf(src); v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return v.Int() != 0 && v.Int() != 1
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return v.Uint() > 1
+	case reflect.Float32, reflect.Float64:
+		return v.Float() != 0 && v.Float() != 1
+	}
+	return false
+}
+
+// isLossyToFloat reports whether the bound float value dst cannot represent
+// the numeric src exactly.
+func isLossyToFloat(dst reflect.Value, src interface{}) bool {
+	switch v := reflect.ValueOf(src); v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return int64(dst.Float()) != v.Int()
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return uint64(dst.Float()) != v.Uint()
+	case reflect.Float32, reflect.Float64:
+		return dst.Float() != v.Float()
+	}
+	return false
+}
+
+// formatNumberAsString formats the numeric src as the plain decimal string
+// without the exponent. Or, return the original src.
+func formatNumberAsString(src interface{}) interface{} {
+	switch v := reflect.ValueOf(src); v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if _, ok := src.(time.Duration); !ok {
+			return strconv.FormatInt(v.Int(), 10)
+		}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return strconv.FormatUint(v.Uint(), 10)
+	case reflect.Float32:
+		return strconv.FormatFloat(v.Float(), 'f', -1, 32)
+	case reflect.Float64:
+		return strconv.FormatFloat(v.Float(), 'f', -1, 64)
+	}
+	return src
+}
+
+func (b binder) bindPointer(dstValue reflect.Value, src interface{}) (err error) {
+	if dstValue.IsNil() {
+		dstValue.Set(reflect.New(dstValue.Type().Elem()))
+	}
+	dstValue = dstValue.Elem()
+	return b.bind(dstValue.Kind(), dstValue, src)
+}
+
+func (b binder) bindInterface(dstValue reflect.Value, src interface{}) (err error) {
+	// Short-circuit the common case that the source can be assigned
+	// to the interface directly, which needs no copy.
+	if src != nil && len(b.dive) == 0 && reflect.TypeOf(src).AssignableTo(dstValue.Type()) {
+		dstValue.Set(reflect.ValueOf(src))
+		return
+	}
+
+	if factory, ok := b.InterfaceFactories[dstValue.Type()]; ok {
+		var value reflect.Value
+		if value, err = factory(src); err != nil {
+			return newConvertError(err)
+		} else if value.IsValid() {
+			return b.bindInterfaceByFactory(dstValue, value, src)
+		}
+	}
+
+	if dstValue.IsValid() && dstValue.Elem().IsValid() { // Interface is set to a specific value.
+		elem := dstValue.Elem()
+
+		// (xgf) If it is a non-nil pointer, its element is addressable,
+		// so we should not new one, and still use the old pointer to check
+		// whether it has implemented the interface Unmarshaler or Setter.
+		if elem.Kind() == reflect.Pointer && !elem.IsNil() {
+			return b.bind(reflect.Pointer, elem, src)
+		}
+
+		// The element in the interface is not addressable, so we make
+		// a copy of the value, decode into that, and replace the whole value.
+		copied := reflect.New(elem.Type()).Elem()
+		copied.Set(elem)
+		if err = b.bind(copied.Kind(), copied, src); err == nil {
+			dstValue.Set(copied)
+		}
+		return
+	}
+
+	srcValue := reflect.ValueOf(src)
+	dstType := dstValue.Type()
+
+	// If the input data is a pointer, and the assigned type is the dereference
+	// of that exact pointer, then indirect it so that we can assign it.
+	// Example: *string to string
+	if srcValue.Kind() == reflect.Pointer && srcValue.Type().Elem() == dstType {
+		srcValue = reflect.Indirect(srcValue)
+	}
+
+	if !srcValue.IsValid() {
+		srcValue = reflect.Zero(dstType)
+	}
+
+	srcType := srcValue.Type()
+	if !srcType.AssignableTo(dstType) {
+		return newConvertError(fmt.Errorf("cannot assign %s to %s", srcType.String(), dstType.String()))
+	}
+
+	dstValue.Set(srcValue)
+	return
+}
+
+// bindInterfaceByFactory binds the source to the value instantiated
+// by the interface factory, and sets the interface to it.
+func (b binder) bindInterfaceByFactory(dstValue, value reflect.Value, src interface{}) (err error) {
+	if value.Kind() != reflect.Pointer {
+		ptr := reflect.New(value.Type())
+		ptr.Elem().Set(value)
+		value = ptr
+	}
+
+	if err = b.bind(reflect.Pointer, value, src); err != nil {
+		return
+	}
+
+	switch dstType := dstValue.Type(); {
+	case value.Type().AssignableTo(dstType):
+		dstValue.Set(value)
+	case value.Elem().Type().AssignableTo(dstType):
+		dstValue.Set(value.Elem())
+	default:
+		return newConvertError(fmt.Errorf("%s does not implement %s",
+			value.Elem().Type().String(), dstType.String()))
+	}
+	return
+}
+
+// mapToList returns the length and elements of the map source
+// for slice/array, which are the keys if MapKeysToSlice, or the values.
+func (b binder) mapToList(srcValue reflect.Value) (int, func(int) interface{}) {
+	keys := srcValue.MapKeys()
+	if b.SortMapToSlice {
+		sortMapKeys(keys)
+	}
+
+	if b.MapKeysToSlice {
+		return len(keys), func(i int) interface{} { return keys[i].Interface() }
+	}
+	return len(keys), func(i int) interface{} { return srcValue.MapIndex(keys[i]).Interface() }
+}
+
+// sortMapKeys sorts the map keys in ascending order.
+func sortMapKeys(keys []reflect.Value) {
+	if len(keys) < 2 {
+		return
+	}
+
+	var less func(i, j int) bool
+	switch keys[0].Kind() {
+	case reflect.String:
+		less = func(i, j int) bool { return keys[i].String() < keys[j].String() }
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		less = func(i, j int) bool { return keys[i].Int() < keys[j].Int() }
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		less = func(i, j int) bool { return keys[i].Uint() < keys[j].Uint() }
+	case reflect.Float32, reflect.Float64:
+		less = func(i, j int) bool { return keys[i].Float() < keys[j].Float() }
+	default:
+		less = func(i, j int) bool { return fmt.Sprint(keys[i].Interface()) < fmt.Sprint(keys[j].Interface()) }
+	}
+	sort.Slice(keys, less)
+}
+
+func (b binder) bindArray(dstValue reflect.Value, src interface{}) (err error) {
+	return b._bindList(dstValue, src, true)
+}
+
+func (b binder) bindSlice(dstValue reflect.Value, src interface{}) (err error) {
+	if s, ok := src.(string); ok && dstValue.Type().Elem().Kind() == reflect.Uint8 {
+		dstValue.SetBytes([]byte(s)) // string => []byte, allowed even in strict mode
+		return
+	}
+	return b._bindList(dstValue, src, false)
+}
+
+func (b binder) _bindList(dstValue reflect.Value, src interface{}, isArray bool) (err error) {
+	dstType := dstValue.Type()
+	ekind := dstType.Elem().Kind()
+
+	var _len int
+	var elem func(int) interface{}
+	switch vs := src.(type) {
+	case []interface{}:
+		_len, elem = len(vs), func(i int) interface{} { return vs[i] }
+
+	case []string:
+		_len, elem = len(vs), func(i int) interface{} { return vs[i] }
+
+	default:
+		srcValue := reflect.ValueOf(src)
+		switch srcValue.Kind() {
+		case reflect.Array, reflect.Slice:
+			_len, elem = srcValue.Len(), func(i int) interface{} { return srcValue.Index(i).Interface() }
+		case reflect.Map:
+			if b.MapToSlice || b.MapKeysToSlice {
+				_len, elem = b.mapToList(srcValue)
+				break
+			}
+			fallthrough
+		default:
+			if !b.ConvertSingleToSlice {
+				return newConvertError(fmt.Errorf("cannot bind single value '%v' to %s field of type %s; enable ConvertSingleToSlice",
+					src, dstType.Kind().String(), dstType.String()))
+			}
+
+			// Bind the single value as the single-element slice.
+			_len, elem = 1, func(int) interface{} { return src }
+		}
+	}
+
+	elems := dstValue
+	if isArray {
+		dstlen := dstValue.Len()
+		if dstlen == 0 {
+			return
+		}
+		if b.ArrayStrictLength && _len != dstlen {
+			return newConvertError(fmt.Errorf("the source length %d does not match the array length %d", _len, dstlen))
+		}
+		if _len > dstlen {
+			// Record the dropped elements as a lossy coercion.
+			b.addCoercion(dstValue, src, true)
+			_len = dstlen
+		}
+	} else {
+		elems = reflect.MakeSlice(dstType, _len, _len)
+	}
+
+	// The element options of the dive level, such as "required".
+	var opts string
+	var dive []string
+	if len(b.dive) > 0 {
+		opts, dive = b.dive[0], b.dive[1:]
+	}
+
+	var n int
+	for i := 0; i < _len; i++ {
+		eb, esrc := b.withIndex(i), elem(i)
+		eb.dive = dive
+
+		if opts != "" && eb.isEmptySource(ekind, esrc) {
+			if hasFieldArg(opts, "required") {
+				return eb.wrapError(ekind, esrc, errors.New("the element is required"))
+			} else if hasFieldArg(opts, "omitempty") {
+				continue
+			}
+		}
+
+		if err = eb.bind(ekind, elems.Index(n), esrc); err != nil {
+			return
+		}
+		n++
+	}
+
+	if !isArray {
+		elems = elems.Slice(0, n)
+		if b.AppendSlices && dstValue.Len() > 0 {
+			elems = reflect.AppendSlice(dstValue, elems)
+		}
+		dstValue.Set(elems)
+	}
+	return
+}
+
+func (b binder) bindMap(dstValue reflect.Value, src interface{}) (err error) {
+	dstType := dstValue.Type()
+	keyType := dstType.Key()
+	valueType := dstType.Elem()
+
+	var dstmaps reflect.Value
+	switch srcmaps := src.(type) {
+	case map[string]interface{}:
+		dstmaps = b.makeMap(dstValue, len(srcmaps))
+		for key, value := range srcmaps {
+			err = b._bindMapIndex(dstmaps, keyType, valueType, key, value)
+			if err != nil {
+				return
+			}
+		}
+
+	case map[string]string:
+		// The same map type has been assigned directly by bind,
+		// so only map[string]interface{} needs the fast path.
+		if dstType == mapStringInterfaceType && b.canCopyMapEntries() {
+			maps := make(map[string]interface{}, len(srcmaps))
+			for key, value := range srcmaps {
+				maps[key] = value
+			}
+			dstValue.Set(reflect.ValueOf(maps))
+			return
+		}
+
+		dstmaps = b.makeMap(dstValue, len(srcmaps))
+		for key, value := range srcmaps {
+			err = b._bindMapIndex(dstmaps, keyType, valueType, key, value)
+			if err != nil {
+				return
+			}
+		}
+
+	default:
+		srcValue := reflect.ValueOf(src)
+		if srcValue.Kind() != reflect.Map {
+			if b.WeaklyTyped && isEmptyList(srcValue) {
+				dstValue.Set(b.makeMap(dstValue, 0))
+				return
+			}
+			return newConvertError(errors.New("cannot bind a map type to a non-map type"))
+		}
+
+		dstmaps = b.makeMap(dstValue, srcValue.Len())
+		for iter := srcValue.MapRange(); iter.Next(); {
+			key, value := iter.Key().Interface(), iter.Value().Interface()
+			err = b._bindMapIndex(dstmaps, keyType, valueType, key, value)
+			if err != nil {
+				return
+			}
+		}
+	}
+
+	dstValue.Set(dstmaps)
+	return
+}
+
+var mapStringInterfaceType = reflect.TypeOf(map[string]interface{}(nil))
+
+// canCopyMapEntries reports whether the entries of the source map can be
+// copied into the new destination map directly, such as map[string]string
+// to map[string]interface{}, without binding each one by reflection,
+// that's, no option intercepts or converts the entry values.
+func (b binder) canCopyMapEntries() bool {
+	return b.Hook == nil && b.PathHook == nil && b.ContextHook == nil && len(b.Converters) == 0 &&
+		len(b.NilStrings) == 0 && len(b.NullStrings) == 0 && len(b.dive) == 0 &&
+		!b.MergeMaps && !b.CopyAssignable && !b.TrimSpace && !b.Strict && b.coercions == nil &&
+		(b.MaxDepth <= 0 || b.depth < b.MaxDepth)
+}
+
+// isStringContainer reports whether the type is the container of strings,
+// such as []string and map[string]string, the strings of which must be
+// trimmed one by one by TrimSpace, instead of assigning the whole.
+func isStringContainer(t reflect.Type) bool {
+	switch t.Kind() {
+	case reflect.Slice, reflect.Array, reflect.Map:
+	default:
+		return false
+	}
+
+	for {
+		switch t.Kind() {
+		case reflect.String:
+			return true
+		case reflect.Slice, reflect.Array, reflect.Map, reflect.Pointer:
+			t = t.Elem()
+		default:
+			return false
+		}
+	}
+}
+
+// isEmptyList reports whether v is an empty slice or array.
+func isEmptyList(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Slice, reflect.Array:
+		return v.Len() == 0
+	default:
+		return false
+	}
+}
+
+// isMergedContainer reports whether the source should be merged
+// into the map or appended to the slice value.
+func (b binder) isMergedContainer(kind reflect.Kind, value reflect.Value) bool {
+	switch kind {
+	case reflect.Map:
+		return b.MergeMaps && !value.IsNil()
+	case reflect.Slice:
+		return b.AppendSlices && value.Len() > 0
+	default:
+		return false
+	}
+}
+
+// makeMap returns the map to store the bound entries, which is the original
+// map dstValue if MergeMaps is true and it is not nil. Or, make a new map.
+func (b binder) makeMap(dstValue reflect.Value, size int) reflect.Value {
+	if b.MergeMaps && !dstValue.IsNil() {
+		return dstValue
+	}
+	return reflect.MakeMapWithSize(dstValue.Type(), size)
+}
+
+func (b binder) _bindMapIndex(dstmap reflect.Value, keyType, valueType reflect.Type, key, value interface{}) (err error) {
+	kb := b
+	kb.keepSpace = true // Only trim the map values, not the keys.
+
+	srckey := reflect.New(keyType)
+	err = kb.bind(keyType.Kind(), srckey.Elem(), key)
+	if err != nil {
+		var be *BindError
+		if errors.As(err, &be) {
+			err = be.Err
+		}
+
+		err = fmt.Errorf("invalid map key '%v': %w", key, err)
+		return b.withField(fmt.Sprint(key)).wrapError(keyType.Kind(), key, err)
+	}
+
+	dstvalue := reflect.New(valueType)
+	if b.MergeMaps && reflect.ValueOf(value).Kind() == reflect.Map {
+		// Merge the nested map recursively by binding into the copy
+		// of the old one.
+		if old := dstmap.MapIndex(srckey.Elem()); old.IsValid() {
+			if old.Kind() == reflect.Interface {
+				old = old.Elem()
+			}
+			if old.Kind() == reflect.Map && !old.IsNil() && old.Type().AssignableTo(valueType) {
+				dstvalue = reflect.New(old.Type())
+				dstvalue.Elem().Set(old)
+			}
+		}
+	}
+
+	err = b.withField(fmt.Sprint(key)).bind(dstvalue.Elem().Kind(), dstvalue.Elem(), value)
+	if err != nil {
+		return
+	}
+
+	dstmap.SetMapIndex(srckey.Elem(), dstvalue.Elem())
+	return
+}
+
+func (b binder) bindStruct(dstStructValue reflect.Value, src interface{}) (err error) {
+	if _, ok := dstStructValue.Interface().(time.Time); ok && !b.DisableTimeSpecialCase {
+		if b.TimeFromParts && reflect.ValueOf(src).Kind() == reflect.Map {
+			return b.bindTimeFromParts(dstStructValue, src)
+		}
+
+		if s, ok := toString(src); ok && b.timeLayout != "" {
+			var v time.Time
+			if v, err = time.ParseInLocation(b.timeLayout, s, b.timeLocation()); err != nil {
+				return newConvertError(err)
+			}
+			dstStructValue.Set(reflect.ValueOf(v))
+			b.addCoercion(dstStructValue, src, false)
+			return
+		}
+
+		var v time.Time
+		if v, err = b.toTime(src); err != nil {
+			return newConvertError(err)
+		}
+		dstStructValue.Set(reflect.ValueOf(v))
+		b.addCoercion(dstStructValue, src, false)
+		return
+	}
+
+	switch dstStructValue.Type() {
+	case urlType:
+		if v, ok := toString(src); ok {
+			return b.bindURL(dstStructValue, v)
+		}
+
+	case ipnetType:
+		if v, ok := toString(src); ok {
+			return b.bindIPNet(dstStructValue, v)
+		}
+	}
+
+	if b.SliceToStruct {
+		switch srcValue := reflect.ValueOf(src); srcValue.Kind() {
+		case reflect.Slice, reflect.Array:
+			return b.bindStructByIndex(dstStructValue, srcValue)
+		}
+	}
+
+	// Bind the struct from another struct field by field.
+	if srcValue := reflect.Indirect(reflect.ValueOf(src)); srcValue.Kind() == reflect.Struct {
+		src = b.structToMap(srcValue)
+	}
+
+	if b.FieldResolver != nil {
+		src = toInterfaceMap(src)
+	}
+
+	var keys map[string]string
+	if b.CaseInsensitive {
+		keys = buildLowerKeys(src)
+	}
+
+	var errs []error
+	fields := field.GetAllFields(dstStructValue.Type())
+	for index, field := range fields {
+		err = b.bindField(dstStructValue.Field(index), field, src, keys)
+		if err != nil {
+			if !b.CollectErrors {
+				return
+			}
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+var (
+	urlType   = reflect.TypeOf(url.URL{})
+	ipnetType = reflect.TypeOf(net.IPNet{})
+)
+
+// toString returns the string if src is a string or []byte.
+func toString(src interface{}) (string, bool) {
+	switch v := src.(type) {
+	case string:
+		return v, true
+	case []byte:
+		return string(v), true
+	default:
+		return "", false
+	}
+}
+
+// bindURL parses the string source by url.Parse and binds it to url.URL.
+func (b binder) bindURL(dstValue reflect.Value, src string) (err error) {
+	u, err := url.Parse(src)
+	if err != nil {
+		return newConvertError(err)
+	}
+
+	dstValue.Set(reflect.ValueOf(u).Elem())
+	b.addCoercion(dstValue, src, false)
+	return
+}
+
+// bindIPNet parses the CIDR string source, such as "10.0.0.0/8",
+// by net.ParseCIDR and binds the network to net.IPNet.
+func (b binder) bindIPNet(dstValue reflect.Value, src string) (err error) {
+	_, ipnet, err := net.ParseCIDR(src)
+	if err != nil {
+		return newConvertError(err)
+	}
+
+	dstValue.Set(reflect.ValueOf(ipnet).Elem())
+	b.addCoercion(dstValue, src, false)
+	return
+}
+
+// structToMap converts the struct source to map[string]interface{}
+// by the field names, which skips the unexported and ignored fields.
+func (b binder) structToMap(v reflect.Value) map[string]interface{} {
+	maps := make(map[string]interface{}, v.NumField())
+	e := structEncoder{getFieldName: b.getFieldName}
+	e.rangeFields(v, func(name, _ string, value reflect.Value) {
+		maps[name] = value.Interface()
+	})
+	return maps
+}
+
+// timeLocation returns TimeLocation, or defaults.TimeLocation if nil.
+func (b binder) timeLocation() *time.Location {
+	if b.TimeLocation != nil {
+		return b.TimeLocation
+	}
+	return defaults.TimeLocation.Get()
+}
+
+// toTime converts src to time.Time by defaults.ToTime,
+// but parses the time string in TimeLocation if set.
+func (b binder) toTime(src interface{}) (v time.Time, err error) {
+	s, isString := toString(src)
+	if isString && b.TimeLocation != nil {
+		for _, layout := range defaults.TimeFormats.Get() {
+			if v, err = time.ParseInLocation(layout, s, b.TimeLocation); err == nil {
+				return
+			}
+		}
+	}
+
+	if v, err = defaults.ToTime(src); err == nil {
+		if b.TimeLocation != nil {
+			v = v.In(b.TimeLocation)
+		}
+		return
+	}
+
+	if isString {
+		for _, layout := range b.timeFormats() {
+			if t, _err := time.ParseInLocation(layout, s, b.timeLocation()); _err == nil {
+				return t, nil
+			}
+		}
+	}
+	return
+}
+
+var defaultTimeFormats = []string{time.DateOnly}
+
+// timeFormats returns TimeFormats, or the date-only layout if empty.
+func (b binder) timeFormats() []string {
+	if len(b.TimeFormats) > 0 {
+		return b.TimeFormats
+	}
+	return defaultTimeFormats
+}
+
+// timeParts is the keys of the date and time parts used by TimeFromParts.
+var timeParts = []string{"year", "month", "day", "hour", "minute", "second", "nanosecond"}
+
+// bindTimeFromParts binds time.Time from the map source containing
+// the date and time parts, such as {"year": 2023, "month": 2, "day": 1}.
+func (b binder) bindTimeFromParts(dstValue reflect.Value, src interface{}) (err error) {
+	// Default: year=1, month=1, day=1, hour=0, minute=0, second=0, nanosecond=0
+	parts := []int{1, 1, 1, 0, 0, 0, 0}
+
+	srcValue := reflect.ValueOf(src)
+	if srcValue.Type().Key().Kind() != reflect.String {
+		return newConvertError(fmt.Errorf("cannot bind %T to time.Time", src))
+	}
+
+	if b.Strict {
+		for iter := srcValue.MapRange(); iter.Next(); {
+			if key := iter.Key().String(); !containsString(timeParts, key) {
+				return newConvertError(fmt.Errorf("unknown time part '%s'", key))
+			}
+		}
+	}
+
+	var found bool
+	keyType := srcValue.Type().Key()
+	for i, key := range timeParts {
+		value := srcValue.MapIndex(reflect.ValueOf(key).Convert(keyType))
+		if !value.IsValid() {
+			continue
+		}
+
+		found = true
+		partValue := reflect.ValueOf(&parts[i]).Elem()
+		if err = b.withField(key).bind(reflect.Int, partValue, value.Interface()); err != nil {
+			return
+		}
+	}
+
+	// Avoid binding the mistyped object to the zero time silently.
+	if !found {
+		return newConvertError(fmt.Errorf("cannot bind %T to time.Time: missing the time parts, such as 'year'", src))
+	}
+
+	t := time.Date(parts[0], time.Month(parts[1]), parts[2], parts[3], parts[4], parts[5], parts[6], b.timeLocation())
+	dstValue.Set(reflect.ValueOf(t))
+	b.addCoercion(dstValue, src, false)
+	return
+}
+
+// getTimeLayout returns the time layout of the struct field
+// by TimeFormatTag.
+func (b binder) getTimeLayout(sf reflect.StructField) string {
+	switch tag := b.TimeFormatTag; tag {
+	case "-":
+		return ""
+	case "":
+		return sf.Tag.Get("timeformat")
+	default:
+		return sf.Tag.Get(tag)
+	}
+}
+
+// isStructByIndex reports whether the struct value is bound
+// from the slice source by index.
+func (b binder) isStructByIndex(kind reflect.Kind, value reflect.Value) bool {
+	return b.SliceToStruct && kind == reflect.Struct &&
+		(b.DisableTimeSpecialCase || value.Type() != timeType)
+}
+
+// bindStructByIndex binds the struct fields in turn from the elements
+// of the slice or array source by index, which skips the unexported
+// and ignored fields. The extra elements are ignored, and the fields
+// without the corresponding elements are left untouched.
+func (b binder) bindStructByIndex(dstStructValue reflect.Value, srcValue reflect.Value) (err error) {
+	var errs []error
+	var index int
+	fields := field.GetAllFields(dstStructValue.Type())
+	for i, field := range fields {
+		if index >= srcValue.Len() {
+			break
+		}
+
+		fieldValue := dstStructValue.Field(i)
+		if !fieldValue.CanSet() {
+			continue
+		}
+
+		name, _ := b.getFieldName(field)
+		if name == "" {
+			continue
+		}
+
+		src := srcValue.Index(index).Interface()
+		index++
+
+		if err = b.withField(name).bind(fieldValue.Kind(), fieldValue, src); err != nil {
+			if !b.CollectErrors {
+				return
+			}
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// buildLowerKeys builds the index from the lowercased key to the original
+// key of the source map with the string key.
+func buildLowerKeys(src interface{}) map[string]string {
+	srcValue := reflect.ValueOf(src)
+	if srcValue.Kind() != reflect.Map || srcValue.Type().Key().Kind() != reflect.String {
+		return nil
 	}
 
-	return
+	keys := make(map[string]string, srcValue.Len())
+	for iter := srcValue.MapRange(); iter.Next(); {
+		key := iter.Key().String()
+		lower := strings.ToLower(key)
+		if orig, ok := keys[lower]; !ok || key < orig {
+			keys[lower] = key
+		}
+	}
+	return keys
 }
 
-func (b binder) bindBool(dstValue reflect.Value, src interface{}) (err error) {
-	v, err := defaults.ToBool(src)
-	if err == nil {
-		dstValue.SetBool(v)
+func (b binder) bindField(fieldValue reflect.Value, fieldType reflect.StructField, src interface{}, keys map[string]string) (err error) {
+	if !fieldValue.CanSet() {
+		return
 	}
-	return
-}
 
-func (b binder) bindInt(dstValue reflect.Value, src interface{}) (err error) {
-	v, err := defaults.ToInt64(src)
-	if err == nil {
-		dstValue.SetInt(v)
+	if err = b.ctx.Err(); err != nil {
+		return
 	}
-	return
-}
 
-func (b binder) bindInt64(dstValue reflect.Value, src interface{}) (err error) {
-	if _, ok := dstValue.Interface().(time.Duration); !ok {
-		return b.bindInt(dstValue, src)
+	name, arg := b.getFieldName(fieldType)
+	if name == "" {
+		return
 	}
 
-	v, err := defaults.ToDuration(src)
-	if err == nil {
-		dstValue.SetInt(int64(v))
+	fieldKind := fieldValue.Kind()
+	if fieldKind == reflect.Struct && (fieldType.Anonymous || isSquashed(arg)) {
+		return b.withSquashPrefix(arg).bindStruct(fieldValue, src)
 	}
-	return
-}
 
-func (b binder) bindUint(dstValue reflect.Value, src interface{}) (err error) {
-	v, err := defaults.ToUint64(src)
-	if err == nil {
-		dstValue.SetUint(v)
+	// Squash the embedded pointer to struct, such as *Base.
+	if fieldKind == reflect.Pointer && (fieldType.Anonymous || isSquashed(arg)) {
+		if elemType := fieldType.Type.Elem(); elemType.Kind() == reflect.Struct && elemType != timeType {
+			if fieldValue.IsNil() {
+				fieldValue.Set(reflect.New(elemType))
+			}
+			return b.withSquashPrefix(arg).bindStruct(fieldValue.Elem(), src)
+		}
 	}
-	return
-}
 
-func (b binder) bindFloat(dstValue reflect.Value, src interface{}) (err error) {
-	v, err := defaults.ToFloat64(src)
-	if err == nil {
-		dstValue.SetFloat(v)
+	name = b.prefix + name
+
+	srcValue := reflect.ValueOf(src)
+	if srcValue.Kind() != reflect.Map {
+		return newConvertError(fmt.Errorf("unsupport to bind a struct to %T", src))
 	}
-	return
-}
 
-func (b binder) bindString(dstValue reflect.Value, src interface{}) (err error) {
-	v, err := defaults.ToString(src)
-	if err == nil {
-		dstValue.SetString(v)
+	fieldOpts, elemOpts := getDiveOptions(fieldType)
+
+	var found bool
+	if srcValue.Len() == 0 {
+		// No field can be found in the empty source.
+	} else if maps, ok := src.(map[string]interface{}); ok && b.FieldResolver != nil {
+		src, found = b.FieldResolver(fieldType, maps)
+	} else if value, ok := lookupMap(srcValue, src, name); ok {
+		src, found = value, true
+	} else if key, ok := keys[strings.ToLower(name)]; ok {
+		keyValue := reflect.ValueOf(key).Convert(srcValue.Type().Key())
+		src, found = srcValue.MapIndex(keyValue).Interface(), true
 	}
-	return
-}
 
-func (b binder) bindPointer(dstValue reflect.Value, src interface{}) (err error) {
-	if dstValue.IsNil() {
-		dstValue.Set(reflect.New(dstValue.Type().Elem()))
+	if b.OnField != nil {
+		b.OnField(b.withField(name).path, fieldType, found)
 	}
-	dstValue = dstValue.Elem()
-	return b.bind(dstValue.Kind(), dstValue, src)
-}
 
-func (b binder) bindInterface(dstValue reflect.Value, src interface{}) (err error) {
-	if dstValue.IsValid() && dstValue.Elem().IsValid() { // Interface is set to a specific value.
-		elem := dstValue.Elem()
-		bindElem := elem
-
-		// If we can't address this element, then its not writable. Instead,
-		// we make a copy of the value (which is a pointer and therefore
-		// writable), decode into that, and replace the whole value.
-		var copied bool
-		if !elem.CanAddr() {
-			if elem.Kind() == reflect.Pointer {
-				// (xgf) If it is a pointer and the element is addressable,
-				// we should not new one, and still use the old.
-				if elem.Elem().CanAddr() {
-					// (xgf) We use the old pointer to check
-					// whether it has implemented the interface
-					// Unmarshaler or Setter.
-					bindElem = elem
-				} else {
-					copied = true
-				}
-			}
-		}
-		if copied {
-			bindElem = reflect.New(elem.Type()) // v = new(T)
-			bindElem.Elem().Set(elem)           // *v = elem
-		}
+	if hasFieldArg(fieldOpts, "required") && (!found || b.isEmptySource(fieldKind, src)) {
+		return b.withField(name).wrapError(fieldKind, src, errors.New("the field is required"))
+	}
 
-		err = b.bind(bindElem.Kind(), elem, src)
-		if err != nil || !copied {
-			return
-		}
+	if !found {
+		return
+	}
 
-		dstValue.Set(elem.Elem()) // elem is copied.
+	if (hasFieldArg(arg, "omitempty") || hasFieldArg(fieldOpts, "omitempty")) && b.isEmptySource(fieldKind, src) {
 		return
 	}
 
-	srcValue := reflect.ValueOf(src)
-	dstType := dstValue.Type()
+	if src == nil && b.NullAsNil {
+		switch fieldKind {
+		case reflect.Pointer, reflect.Interface, reflect.Slice, reflect.Map:
+			fieldValue.Set(reflect.Zero(fieldValue.Type()))
+		}
+	}
 
-	// If the input data is a pointer, and the assigned type is the dereference
-	// of that exact pointer, then indirect it so that we can assign it.
-	// Example: *string to string
-	if srcValue.Kind() == reflect.Pointer && srcValue.Type().Elem() == dstType {
-		srcValue = reflect.Indirect(srcValue)
+	if fieldKind == reflect.String && hasFieldArg(arg, "asString") {
+		src = formatNumberAsString(src)
 	}
 
-	if !srcValue.IsValid() {
-		srcValue = reflect.Zero(dstType)
+	if hasFieldArg(arg, "base64") {
+		var data interface{}
+		if data, err = decodeBase64Source(fieldType.Type, src); err != nil {
+			return b.withField(name).wrapError(fieldKind, src, err)
+		}
+		src = data
 	}
 
-	srcType := srcValue.Type()
-	if !srcType.AssignableTo(dstType) {
-		return fmt.Errorf("cannot assign %s to %s", srcType.String(), dstType.String())
+	if hasFieldArg(arg, "json") {
+		var data interface{}
+		if data, err = decodeJSONSource(fieldType.Type, src); err != nil {
+			return b.withField(name).wrapError(fieldKind, src, err)
+		}
+		src = data
 	}
 
-	dstValue.Set(srcValue)
+	if explode, ok := lookupFieldArg(arg, "explode"); ok && explode == "false" {
+		src = b.splitExplodedSource(fieldType.Type, src)
+	}
+
+	if maxlen, ok := lookupFieldArg(arg, "maxlen"); ok {
+		if err = checkMaxLen(fieldValue, src, maxlen); err != nil {
+			return b.withField(name).wrapError(fieldKind, src, err)
+		}
+	}
+
+	if b.ZeroFields {
+		fieldValue.Set(reflect.Zero(fieldValue.Type()))
+	}
+
+	fb := b.withField(name)
+	fb.dive = elemOpts
+	fb.timeLayout = b.getTimeLayout(fieldType)
+	if err = fb.bind(fieldKind, fieldValue, src); err == nil && b.AfterField != nil {
+		if err = b.AfterField(fieldValue, fieldType, src); err != nil {
+			err = fb.wrapError(fieldKind, src, err)
+		}
+	}
+	if err == nil && b.FieldValidator != nil {
+		if err = b.FieldValidator(fieldType, fieldValue); err != nil {
+			err = fb.wrapError(fieldKind, src, err)
+		}
+	}
 	return
 }
 
-func (b binder) bindArray(dstValue reflect.Value, src interface{}) (err error) {
-	return b._bindList(dstValue, src, true)
+// lookupMap looks up the value by the key from the map source,
+// which avoids the reflection for the common map types.
+func lookupMap(srcValue reflect.Value, src interface{}, key string) (value interface{}, ok bool) {
+	switch maps := src.(type) {
+	case map[string]interface{}:
+		value, ok = maps[key]
+	case map[string]string:
+		value, ok = maps[key]
+	case map[string][]string:
+		value, ok = maps[key]
+	case url.Values:
+		value, ok = maps[key]
+	default:
+		if v := srcValue.MapIndex(reflect.ValueOf(key)); v.IsValid() {
+			value, ok = v.Interface(), true
+		}
+	}
+	return
 }
 
-func (b binder) bindSlice(dstValue reflect.Value, src interface{}) (err error) {
-	return b._bindList(dstValue, src, false)
-}
+var (
+	setterType          = reflect.TypeOf((*Setter)(nil)).Elem()
+	unmarshalerType     = reflect.TypeOf((*Unmarshaler)(nil)).Elem()
+	textUnmarshalerType = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+)
 
-func (b binder) _bindList(dstValue reflect.Value, src interface{}, isArray bool) (err error) {
-	dstType := dstValue.Type()
-	ekind := dstType.Elem().Kind()
+// decodeBase64Source decodes the base64 string source for the field type,
+// and unmarshals the decoded bytes as JSON if the field is a container.
+func decodeBase64Source(fieldType reflect.Type, src interface{}) (interface{}, error) {
+	s, ok := toString(src)
+	if !ok {
+		return src, nil
+	}
 
-	var _len int
-	var bind func(reflect.Value, int) error
-	switch vs := src.(type) {
-	case []interface{}:
-		_len = len(vs)
-		bind = func(v reflect.Value, i int) error { return b.bind(ekind, v, vs[i]) }
+	data, err := base64.StdEncoding.DecodeString(s)
+	if err != nil {
+		return nil, fmt.Errorf("invalid base64 source: %w", err)
+	}
 
-	case []string:
-		_len = len(vs)
-		bind = func(v reflect.Value, i int) error { return b.bind(ekind, v, vs[i]) }
+	if isBytesType(fieldType) {
+		return data, nil
+	} else if !isJSONContainer(fieldType) {
+		return string(data), nil
+	}
 
-	default:
-		srcValue := reflect.ValueOf(src)
-		switch srcValue.Kind() {
-		case reflect.Array, reflect.Slice:
-			_len = srcValue.Len()
-			bind = func(v reflect.Value, i int) error {
-				return b.bind(ekind, v, srcValue.Index(i).Interface())
-			}
-		default:
+	var value interface{}
+	if err = json.Unmarshal(data, &value); err != nil {
+		return nil, fmt.Errorf("invalid JSON in base64 source: %w", err)
+	}
+	return value, nil
+}
 
-			return errors.New("cannot bind a slice type to a non-array/slice type")
-		}
+// decodeJSONSource unmarshals the JSON object or array string source,
+// such as `{"status":"open"}`, if the field is a container.
+// For the source of []string, such as url.Values, only one is allowed.
+func decodeJSONSource(fieldType reflect.Type, src interface{}) (interface{}, error) {
+	if ss, ok := src.([]string); ok && len(ss) == 1 {
+		src = ss[0]
 	}
 
-	elems := dstValue
-	if isArray {
-		dstlen := dstValue.Len()
-		if dstlen == 0 {
-			return
-		}
-		if _len < dstlen {
-			_len = dstlen
-		}
-	} else {
-		elems = reflect.MakeSlice(dstType, _len, _len)
+	s, ok := toString(src)
+	if !ok || !isJSONContainer(fieldType) {
+		return src, nil
 	}
 
-	for i := 0; i < _len; i++ {
-		if err = bind(elems.Index(i), i); err != nil {
-			return
-		}
+	// Only the JSON-looking string is unmarshaled.
+	if s = strings.TrimSpace(s); s == "" || (s[0] != '{' && s[0] != '[') {
+		return src, nil
 	}
 
-	if !isArray {
-		dstValue.Set(elems)
+	var value interface{}
+	if err := json.Unmarshal([]byte(s), &value); err != nil {
+		return nil, fmt.Errorf("invalid JSON source: %w", err)
 	}
-	return
+	return value, nil
 }
 
-func (b binder) bindMap(dstValue reflect.Value, src interface{}) (err error) {
-	dstType := dstValue.Type()
-	keyType := dstType.Key()
-	valueType := dstType.Elem()
+// splitExplodedSource splits the string source, or each string of
+// the []string source, by ExplodeSeparator if the field is a slice/array.
+func (b binder) splitExplodedSource(fieldType reflect.Type, src interface{}) interface{} {
+	for fieldType.Kind() == reflect.Pointer {
+		fieldType = fieldType.Elem()
+	}
 
-	var dstmaps reflect.Value
-	switch srcmaps := src.(type) {
-	case map[string]interface{}:
-		dstmaps = reflect.MakeMapWithSize(dstType, len(srcmaps))
-		for key, value := range srcmaps {
-			err = b._bindMapIndex(dstmaps, keyType, valueType, key, value)
-			if err != nil {
-				return
-			}
+	switch fieldType.Kind() {
+	case reflect.Slice, reflect.Array:
+		if isBytesType(fieldType) {
+			return src
 		}
+	default:
+		return src
+	}
 
-	case map[string]string:
-		dstmaps = reflect.MakeMapWithSize(dstType, len(srcmaps))
-		for key, value := range srcmaps {
-			err = b._bindMapIndex(dstmaps, keyType, valueType, key, value)
-			if err != nil {
-				return
-			}
-		}
+	sep := b.ExplodeSeparator
+	if sep == "" {
+		sep = ","
+	}
 
+	var ss []string
+	switch v := src.(type) {
+	case string:
+		ss = []string{v}
+	case []string:
+		ss = v
 	default:
-		srcValue := reflect.ValueOf(src)
-		if srcValue.Kind() != reflect.Map {
-			return errors.New("cannot bind a map type to a non-map type")
-		}
+		return src
+	}
 
-		dstmaps = reflect.MakeMapWithSize(dstType, srcValue.Len())
-		for iter := srcValue.MapRange(); iter.Next(); {
-			key, value := iter.Key().Interface(), iter.Value().Interface()
-			err = b._bindMapIndex(dstmaps, keyType, valueType, key, value)
-			if err != nil {
-				return
-			}
+	values := make([]string, 0, len(ss))
+	for _, s := range ss {
+		if s != "" {
+			values = append(values, strings.Split(s, sep)...)
 		}
 	}
-
-	dstValue.Set(dstmaps)
-	return
+	return values
 }
 
-func (b binder) _bindMapIndex(dstmap reflect.Value, keyType, valueType reflect.Type, key, value interface{}) (err error) {
-	srckey := reflect.New(keyType)
-	err = b.bind(keyType.Kind(), srckey.Elem(), key)
-	if err != nil {
-		return
+// isBytesType reports whether the type is []byte or the pointer to it.
+func isBytesType(t reflect.Type) bool {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
 	}
+	return t.Kind() == reflect.Slice && t.Elem().Kind() == reflect.Uint8
+}
 
-	dstvalue := reflect.New(valueType)
-	err = b.bind(valueType.Kind(), dstvalue.Elem(), value)
-	if err != nil {
-		return
+// isJSONContainer reports whether the type, or the pointer to it,
+// is a struct except time.Time, map, array, or slice except []byte,
+// which may be bound from the unmarshaled JSON value.
+func isJSONContainer(t reflect.Type) bool {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
 	}
 
-	dstmap.SetMapIndex(srckey.Elem(), dstvalue.Elem())
-	return
+	switch t.Kind() {
+	case reflect.Struct:
+		return t != timeType
+	case reflect.Slice:
+		return t.Elem().Kind() != reflect.Uint8
+	case reflect.Map, reflect.Array:
+		return true
+	default:
+		return false
+	}
 }
 
-func (b binder) bindStruct(dstStructValue reflect.Value, src interface{}) (err error) {
-	if _, ok := dstStructValue.Interface().(time.Time); ok {
-		var v time.Time
-		if v, err = defaults.ToTime(src); err == nil {
-			dstStructValue.Set(reflect.ValueOf(v))
-		}
-		return
+// checkMaxLen checks whether the length of the string or []byte source
+// exceeds maxlen when the value is a encoding.TextUnmarshaler or []byte.
+func checkMaxLen(value reflect.Value, src interface{}, maxlen string) error {
+	max, err := strconv.Atoi(maxlen)
+	if err != nil {
+		return fmt.Errorf("invalid maxlen '%s'", maxlen)
 	}
 
-	fields := field.GetAllFields(dstStructValue.Type())
-	for index, field := range fields {
-		err = b.bindField(dstStructValue.Field(index), field, src)
-		if err != nil {
-			return
-		}
+	vtype := value.Type()
+	for vtype.Kind() == reflect.Pointer {
+		vtype = vtype.Elem()
 	}
-	return
+
+	isBytes := vtype.Kind() == reflect.Slice && vtype.Elem().Kind() == reflect.Uint8
+	if !isBytes && !reflect.PointerTo(vtype).Implements(textUnmarshalerType) {
+		return nil
+	}
+
+	return checkSourceMaxLen(src, max)
 }
 
-func (b binder) bindField(fieldValue reflect.Value, fieldType reflect.StructField, src interface{}) (err error) {
-	if !fieldValue.CanSet() {
-		return
+// checkSourceMaxLen checks the length of the string or []byte source,
+// or each element of the []string or []interface{} source, such as url.Values,
+// which may be converted to the single value later.
+func checkSourceMaxLen(src interface{}, max int) error {
+	var _len int
+	switch v := src.(type) {
+	case string:
+		_len = len(v)
+	case []byte:
+		_len = len(v)
+	case []string:
+		for _, s := range v {
+			if err := checkSourceMaxLen(s, max); err != nil {
+				return err
+			}
+		}
+		return nil
+	case []interface{}:
+		for _, e := range v {
+			if err := checkSourceMaxLen(e, max); err != nil {
+				return err
+			}
+		}
+		return nil
+	default:
+		return nil
 	}
 
-	name, arg := b.getFieldName(fieldType)
-	if name == "" {
-		return
+	if _len > max {
+		return fmt.Errorf("the source length %d exceeds the max length %d", _len, max)
 	}
+	return nil
+}
 
-	fieldKind := fieldValue.Kind()
-	if fieldKind == reflect.Struct && (fieldType.Anonymous || arg == "squash") {
-		return b.bindStruct(fieldValue, src)
+// isEmptySource reports whether the source value bound to the value
+// with the given kind is empty, that's, nil, the zero value, or the empty
+// slice/array/map.
+func (b binder) isEmptySource(kind reflect.Kind, src interface{}) bool {
+	if src == nil {
+		return true
 	}
 
 	srcValue := reflect.ValueOf(src)
-	if srcValue.Kind() != reflect.Map {
-		return fmt.Errorf("unsupport to bind a struct to %T", src)
-	} else if srcValue.Len() == 0 {
-		return
+	switch srcValue.Kind() {
+	case reflect.Slice, reflect.Array:
+		if srcValue.Len() == 0 {
+			return true
+		}
+
+		// Check the first element that will be bound actually.
+		if b.ConvertSliceToSingle && kind != reflect.Array && kind != reflect.Slice {
+			return b.isEmptySource(kind, srcValue.Index(b.singleIndex(srcValue.Len())).Interface())
+		}
+		return false
+
+	case reflect.Map:
+		return srcValue.Len() == 0
+
+	default:
+		return srcValue.IsZero()
 	}
+}
+
+// toInterfaceMap converts the map with the string key to map[string]interface{}.
+// Or, return the original src.
+func toInterfaceMap(src interface{}) interface{} {
+	switch srcmaps := src.(type) {
+	case map[string]interface{}:
+		return srcmaps
+
+	case map[string]string:
+		maps := make(map[string]interface{}, len(srcmaps))
+		for key, value := range srcmaps {
+			maps[key] = value
+		}
+		return maps
+
+	default:
+		srcValue := reflect.ValueOf(src)
+		if srcValue.Kind() != reflect.Map || srcValue.Type().Key().Kind() != reflect.String {
+			return src
+		}
 
-	if value := srcValue.MapIndex(reflect.ValueOf(name)); value.IsValid() {
-		err = b.bind(fieldKind, fieldValue, value.Interface())
+		maps := make(map[string]interface{}, srcValue.Len())
+		for iter := srcValue.MapRange(); iter.Next(); {
+			maps[iter.Key().String()] = iter.Value().Interface()
+		}
+		return maps
 	}
+}
+
+// hasFieldArg reports whether the field argument arg contains the option opt.
+func hasFieldArg(arg, opt string) bool {
+	_, ok := lookupFieldArg(arg, opt)
+	return ok
+}
 
+// lookupFieldArg looks up the option opt, which is like "opt" or "opt=value",
+// from the field argument arg that is separated by the comma.
+func lookupFieldArg(arg, opt string) (value string, ok bool) {
+	for len(arg) > 0 {
+		var item string
+		if index := strings.IndexByte(arg, ','); index > -1 {
+			item, arg = arg[:index], arg[index+1:]
+		} else {
+			item, arg = arg, ""
+		}
+
+		item = strings.TrimSpace(item)
+		if item == opt {
+			return "", true
+		} else if strings.HasPrefix(item, opt) && item[len(opt)] == '=' {
+			return strings.TrimSpace(item[len(opt)+1:]), true
+		}
+	}
 	return
 }
@@ -0,0 +1,68 @@
+// Copyright 2023 xgfone
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package binder
+
+import (
+	"strconv"
+	"testing"
+)
+
+func BenchmarkBinder_mapOfStrings(b *testing.B) {
+	src := make(map[string]string, 1000)
+	for i := 0; i < 1000; i++ {
+		src["key"+strconv.Itoa(i)] = strconv.Itoa(i)
+	}
+	srcmap := map[string]interface{}{"data": src}
+
+	var dst struct {
+		Data map[string]interface{} `json:"data"`
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if err := BindStructToMap(&dst, "json", srcmap); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkBinder_interfaces(b *testing.B) {
+	var dst struct {
+		F0 interface{} `json:"f0"`
+		F1 interface{} `json:"f1"`
+		F2 interface{} `json:"f2"`
+		F3 interface{} `json:"f3"`
+		F4 interface{} `json:"f4"`
+		F5 interface{} `json:"f5"`
+		F6 interface{} `json:"f6"`
+		F7 interface{} `json:"f7"`
+		F8 interface{} `json:"f8"`
+		F9 interface{} `json:"f9"`
+	}
+
+	src := make(map[string]interface{}, 10)
+	for i := 0; i < 10; i++ {
+		src["f"+strconv.Itoa(i)] = i
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if err := BindStructToMap(&dst, "json", src); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,61 @@
+// Copyright 2023 xgfone
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package binder
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+)
+
+var (
+	enumLock  sync.RWMutex
+	enumTypes = make(map[reflect.Type]func(name string) (reflect.Value, error))
+)
+
+// RegisterEnum registers the names of the enum type T, so that the binder
+// binds the string source to T by the name, such as "active" to Status(1).
+//
+// For the unknown name, the binder returns an error. And the non-string
+// source is still bound as the normal integer.
+//
+// If the enum type has been registered, override it.
+func RegisterEnum[T ~int](name2val map[string]T) {
+	names := make(map[string]T, len(name2val))
+	for name, val := range name2val {
+		names[name] = val
+	}
+
+	vtype := reflect.TypeOf(T(0))
+	enumLock.Lock()
+	defer enumLock.Unlock()
+	enumTypes[vtype] = func(name string) (reflect.Value, error) {
+		if val, ok := names[name]; ok {
+			return reflect.ValueOf(val), nil
+		}
+		return reflect.Value{}, fmt.Errorf("unknown enum name '%s' for %s", name, vtype.String())
+	}
+}
+
+// lookupEnum returns the function to parse the enum name for the type,
+// which returns nil if the type is not registered by RegisterEnum.
+func lookupEnum(vtype reflect.Type) func(string) (reflect.Value, error) {
+	enumLock.RLock()
+	defer enumLock.RUnlock()
+	if len(enumTypes) == 0 {
+		return nil
+	}
+	return enumTypes[vtype]
+}
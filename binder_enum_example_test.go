@@ -0,0 +1,54 @@
+// Copyright 2023 xgfone
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package binder
+
+import "fmt"
+
+type Status int
+
+const (
+	StatusInactive Status = iota
+	StatusActive
+	StatusDeleted
+)
+
+func ExampleRegisterEnum() {
+	RegisterEnum(map[string]Status{
+		"inactive": StatusInactive,
+		"active":   StatusActive,
+		"deleted":  StatusDeleted,
+	})
+
+	var user struct {
+		Status  Status  `json:"status"`
+		Status2 *Status `json:"status2"`
+		Status3 Status  `json:"status3"`
+	}
+
+	src := map[string]interface{}{"status": "active", "status2": "deleted", "status3": 0}
+	if err := BindStructToMap(&user, "json", src); err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	fmt.Println(user.Status, *user.Status2, user.Status3)
+
+	err := BindStructToMap(&user, "json", map[string]interface{}{"status": "unknown"})
+	fmt.Println(err)
+
+	// Output:
+	// 1 2 0
+	// path "status": unknown enum name 'unknown' for binder.Status
+}
@@ -0,0 +1,128 @@
+// Copyright 2023 xgfone
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package binder
+
+import (
+	"os"
+	"reflect"
+	"strings"
+
+	"github.com/xgfone/go-structs/field"
+)
+
+// BindStructToEnv binds the struct to the environment variables
+// with the prefix, such as "APP_".
+//
+// For the key name, it uses the value of the tag, such as "env", if set.
+// Or, convert the field name to the upper snake case, such as "DBHost"
+// to "DB_HOST". For the nested struct, the key name of its fields is
+// prefixed by the key name of the struct field and the underscore,
+// for example, the field Host of the nested struct field DB is "APP_DB_HOST".
+//
+// If the field is addressed by both the tag name and the converted name,
+// the environment variable of the tag name takes precedence.
+func BindStructToEnv(structptr interface{}, tag, prefix string) error {
+	v, err := getStructValue(structptr)
+	if err != nil {
+		return err
+	}
+
+	envs := make(map[string]string, 32)
+	for _, env := range os.Environ() {
+		if key, value, ok := strings.Cut(env, "="); ok && strings.HasPrefix(key, prefix) {
+			envs[key[len(prefix):]] = value
+		}
+	}
+
+	binder := NewBinder()
+	binder.GetFieldName = func(sf reflect.StructField) (name, arg string) {
+		if name, arg = field.GetTag(sf, tag); name != "-" {
+			name = sf.Name
+		} else {
+			name = ""
+		}
+		return
+	}
+
+	return binder.Bind(structptr, buildEnvSource(v.Type(), tag, "", envs))
+}
+
+// buildEnvSource builds the source map, the key of which is the field name,
+// of the struct type from the environment variables.
+func buildEnvSource(t reflect.Type, tag, prefix string, envs map[string]string) map[string]interface{} {
+	maps := make(map[string]interface{}, t.NumField())
+	for _, sf := range field.GetAllFields(t) {
+		if !sf.IsExported() {
+			continue
+		}
+
+		tagName, _ := field.GetTag(sf, tag)
+		if tagName == "-" {
+			continue
+		}
+
+		if isNestedEnvStruct(sf.Type) {
+			ft := sf.Type
+			if ft.Kind() == reflect.Pointer {
+				ft = ft.Elem()
+			}
+
+			if sf.Anonymous {
+				for key, value := range buildEnvSource(ft, tag, prefix, envs) {
+					maps[key] = value
+				}
+				continue
+			}
+
+			name := tagName
+			if name == "" {
+				name = splitCamelCase(sf.Name, '_')
+			}
+
+			if submaps := buildEnvSource(ft, tag, prefix+strings.ToUpper(name)+"_", envs); len(submaps) > 0 {
+				maps[sf.Name] = submaps
+			}
+			continue
+		}
+
+		if tagName != "" {
+			if value, ok := envs[prefix+tagName]; ok {
+				maps[sf.Name] = value
+				continue
+			}
+		}
+
+		if value, ok := envs[prefix+strings.ToUpper(splitCamelCase(sf.Name, '_'))]; ok {
+			maps[sf.Name] = value
+		}
+	}
+	return maps
+}
+
+// isNestedEnvStruct reports whether the type is a struct or a pointer to
+// struct, which is bound field by field, not by the interfaces.
+func isNestedEnvStruct(t reflect.Type) bool {
+	if t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	if t.Kind() != reflect.Struct || t == timeType {
+		return false
+	}
+
+	pt := reflect.PointerTo(t)
+	return !pt.Implements(unmarshalerType) && !pt.Implements(setterType) &&
+		!pt.Implements(textUnmarshalerType)
+}
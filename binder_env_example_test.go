@@ -0,0 +1,63 @@
+// Copyright 2023 xgfone
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package binder
+
+import (
+	"fmt"
+	"os"
+	"time"
+)
+
+func ExampleBindStructToEnv() {
+	envs := map[string]string{
+		"APP_NAME":         "app",
+		"APP_DEBUG":        "true",
+		"APP_LISTEN_ADDR":  "127.0.0.1:80",
+		"APP_PORT":         "8080", // Addressed by the tag
+		"APP_HTTP_PORT":    "9090", // Addressed by the converted name, but ignored.
+		"APP_DB_HOST":      "localhost",
+		"APP_DB_TIMEOUT":   "3s",
+		"APP_DB_MAX_CONNS": "100",
+		"APP_IGNORE":       "ignore",
+	}
+	for key, value := range envs {
+		os.Setenv(key, value)
+		defer os.Unsetenv(key)
+	}
+
+	var config struct {
+		Name       string
+		Debug      bool
+		ListenAddr string
+		HTTPPort   int    `env:"PORT"`
+		Ignore     string `env:"-"`
+		DB         struct {
+			Host     string
+			Timeout  time.Duration
+			MaxConns int
+		}
+	}
+
+	err := BindStructToEnv(&config, "env", "APP_")
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	fmt.Printf("%+v\n", config)
+
+	// Output:
+	// {Name:app Debug:true ListenAddr:127.0.0.1:80 HTTPPort:8080 Ignore: DB:{Host:localhost Timeout:3s MaxConns:100}}
+}
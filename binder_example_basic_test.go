@@ -16,7 +16,9 @@ package binder
 
 import (
 	"fmt"
+	"math"
 	"reflect"
+	"sort"
 	"time"
 )
 
@@ -74,3 +76,366 @@ func ExampleBinder_Basic() {
 	// 30 <nil>
 	// 40 <nil>
 }
+
+func ExampleBinder_NilStrings() {
+	binder := NewBinder()
+	binder.NilStrings = []string{"undefined", "null"}
+
+	var S struct {
+		IntPtr *int
+		Int    int
+		String string
+	}
+
+	value := 123
+	S.IntPtr = &value
+	err := binder.Bind(&S, map[string]interface{}{"IntPtr": "undefined"})
+	fmt.Printf("IntPtr=%v, err=%v\n", S.IntPtr, err)
+
+	err = binder.Bind(&S, map[string]interface{}{"Int": "undefined"})
+	fmt.Printf("Int=%v, err=%v\n", S.Int, err)
+
+	err = binder.Bind(&S, map[string]interface{}{"String": "null"})
+	fmt.Printf("String=%v, err=%v\n", S.String, err)
+
+	// Output:
+	// IntPtr=<nil>, err=<nil>
+	// Int=0, err=path "Int": cannot bind the nil string 'undefined' to int
+	// String=null, err=<nil>
+}
+
+func ExampleBinder_NullStrings() {
+	binder := NewBinder(WithNullStrings(true, "null", "nil"))
+
+	var S struct {
+		IntPtr *int
+		Int    int
+		String string
+		Ints   []int
+	}
+
+	value := 123
+	S.IntPtr, S.Int, S.String, S.Ints = &value, 456, "abc", []int{1, 2}
+	err := binder.Bind(&S, map[string]interface{}{
+		"IntPtr": "NULL",
+		"Int":    "null",
+		"String": "Nil",
+		"Ints":   "null",
+	})
+
+	fmt.Printf("IntPtr=%v, Int=%v, String=%q, Ints=%v, err=%v\n", S.IntPtr, S.Int, S.String, S.Ints, err)
+
+	// Output:
+	// IntPtr=<nil>, Int=0, String="", Ints=[], err=<nil>
+}
+
+func ExampleBindWithCoercions() {
+	var S struct {
+		Int1   int
+		Int2   int
+		String string
+		Items  []struct {
+			Float32 float32
+		}
+		Same int
+	}
+
+	maps := map[string]interface{}{
+		"Int1":   1.5,
+		"Int2":   2.0,
+		"String": 123,
+		"Items":  []map[string]interface{}{{"Float32": 1.25}, {"Float32": 1.1}},
+		"Same":   1,
+	}
+
+	coercions, err := BindWithCoercions(&S, maps)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	sort.Slice(coercions, func(i, j int) bool { return coercions[i].Path < coercions[j].Path })
+	for _, c := range coercions {
+		fmt.Printf("%s: %s => %s, lossy=%v\n", c.Path, c.SrcType, c.DstType, c.Lossy)
+	}
+
+	// Output:
+	// Int1: float64 => int, lossy=true
+	// Int2: float64 => int, lossy=false
+	// Items[0].Float32: float64 => float32, lossy=false
+	// Items[1].Float32: float64 => float32, lossy=true
+	// String: int => string, lossy=false
+}
+
+func ExampleBinder_AllowOverflow() {
+	var i16 int16
+	var u8 uint8
+
+	fmt.Println(Bind(&i16, 70000), i16)
+	fmt.Println(Bind(&u8, "256"), u8)
+	fmt.Println(Bind(&i16, 32767), i16)
+
+	binder := NewBinder()
+	binder.AllowOverflow = true
+	fmt.Println(binder.Bind(&i16, 70000), i16)
+
+	// The float source out of the range of int64 or uint64.
+	var i64 int64
+	var u64 uint64
+	var d time.Duration
+	fmt.Println(Bind(&i64, 1e20), i64)
+	fmt.Println(Bind(&u64, 1e20), u64)
+	fmt.Println(Bind(&d, 1e10), d)
+
+	// The integer source scaled by DurationUnit out of the range of time.Duration.
+	binder = NewBinder()
+	binder.DurationUnit = time.Hour
+	fmt.Println(binder.Bind(&d, 1<<40), d)
+	fmt.Println(binder.Bind(&d, "1099511627776"), d)
+	fmt.Println(binder.Bind(&d, 2), d)
+
+	// Output:
+	// value 70000 overflows int16 0
+	// value 256 overflows uint8 0
+	// <nil> 32767
+	// <nil> 4464
+	// value 1e+20 overflows int64 0
+	// value 1e+20 overflows uint64 0
+	// value 1e+10 overflows time.Duration 0s
+	// value 1099511627776 overflows time.Duration 0s
+	// value 1099511627776 overflows time.Duration 0s
+	// <nil> 2h0m0s
+}
+
+func ExampleBind_negativeToUnsigned() {
+	var S struct {
+		Uint32 uint32
+		Uint   uint
+	}
+
+	fmt.Println(Bind(&S, map[string]interface{}{"Uint32": -5}))
+	fmt.Println(Bind(&S, map[string]interface{}{"Uint": "-1"}))
+	fmt.Println(Bind(&S, map[string]interface{}{"Uint": -1.5}))
+
+	// The negative zero is not negative.
+	fmt.Println(Bind(&S, map[string]interface{}{"Uint": "-0"}), S.Uint)
+	fmt.Println(Bind(&S, map[string]interface{}{"Uint": math.Copysign(0, -1)}), S.Uint)
+
+	// Output:
+	// path "Uint32": cannot bind negative value -5 to uint32
+	// path "Uint": cannot bind negative value -1 to uint
+	// path "Uint": cannot bind negative value -1.5 to uint
+	// <nil> 0
+	// <nil> 0
+}
+
+func ExampleBinder_Strict() {
+	var S struct {
+		Int      int
+		Float    float64
+		String   string
+		Bool     bool
+		Duration time.Duration
+		Time     time.Time
+		Bytes    []byte
+	}
+
+	binder := NewBinder()
+	binder.Strict = true
+
+	fmt.Println(binder.Bind(&S, map[string]interface{}{"Int": "12"}))
+	fmt.Println(binder.Bind(&S, map[string]interface{}{"String": 40}))
+	fmt.Println(binder.Bind(&S, map[string]interface{}{"Bool": 1}))
+
+	err := binder.Bind(&S, map[string]interface{}{
+		"Int":      12.0,
+		"Float":    30,
+		"String":   "abc",
+		"Bool":     true,
+		"Duration": "1s",
+		"Time":     "2023-02-01T00:00:00Z",
+		"Bytes":    "xyz",
+	})
+	fmt.Println(err)
+	fmt.Println(S.Int, S.Float, S.String, S.Bool, S.Duration, S.Time.Format(time.RFC3339), string(S.Bytes))
+
+	// Output:
+	// path "Int": cannot bind string to int in strict mode
+	// path "String": cannot bind int to string in strict mode
+	// path "Bool": cannot bind int to bool in strict mode
+	// <nil>
+	// 12 30 abc true 1s 2023-02-01T00:00:00Z xyz
+}
+
+func ExampleBinder_DurationUnit() {
+	srcs := []interface{}{30, 1.5, "30", "1s"}
+	for _, unit := range []time.Duration{0, time.Nanosecond, time.Millisecond, time.Second, time.Minute} {
+		binder := NewBinder()
+		binder.DurationUnit = unit
+
+		durations := make([]time.Duration, len(srcs))
+		for i, src := range srcs {
+			if err := binder.Bind(&durations[i], src); err != nil {
+				fmt.Println(err)
+				return
+			}
+		}
+		fmt.Printf("Unit=%s: %v\n", unit, durations)
+	}
+
+	// Output:
+	// Unit=0s: [30ms 1.5s 30ms 1s]
+	// Unit=1ns: [30ns 1ns 30ns 1s]
+	// Unit=1ms: [30ms 1.5ms 30ms 1s]
+	// Unit=1s: [30s 1.5s 30s 1s]
+	// Unit=1m0s: [30m0s 1m30s 30m0s 1s]
+}
+
+func ExampleBind_durationString() {
+	for _, src := range []string{"1h30m15s", "1h30m", "1.5s", "500ms", "2us", "+1m", "-1h30m", "10 seconds"} {
+		var d time.Duration
+		if err := Bind(&d, src); err != nil {
+			fmt.Println(err)
+		} else {
+			fmt.Printf("%s => %s\n", src, d)
+		}
+	}
+
+	// Output:
+	// 1h30m15s => 1h30m15s
+	// 1h30m => 1h30m0s
+	// 1.5s => 1.5s
+	// 500ms => 500ms
+	// 2us => 2µs
+	// +1m => 1m0s
+	// -1h30m => -1h30m0s
+	// invalid duration '10 seconds', which should be a number or like "1h30m": time: unknown unit " seconds" in duration "10 seconds"
+}
+
+func ExampleBinder_WeaklyTyped() {
+	type Config struct {
+		Port    int               `json:"port"`
+		Debug   bool              `json:"debug"`
+		Verbose string            `json:"verbose"`
+		Version string            `json:"version"`
+		Hosts   []string          `json:"hosts"`
+		Name    string            `json:"name"`
+		Labels  map[string]string `json:"labels"`
+	}
+
+	src := map[string]interface{}{
+		"port":    "",                 // empty string => 0
+		"debug":   "",                 // empty string => false
+		"verbose": true,               // bool => "1"
+		"version": 1.2,                // number => "1.2"
+		"hosts":   "localhost",        // single value => []string{"localhost"}
+		"name":    []string{"a", "b"}, // slice => "a"
+		"labels":  []interface{}{},    // empty slice => empty map
+	}
+
+	var c Config
+	err := NewBinder(WithWeaklyTyped()).Bind(&c, src)
+	fmt.Printf("%+v, %v\n", c, err)
+
+	// Output:
+	// {Port:0 Debug:false Verbose:1 Version:1.2 Hosts:[localhost] Name:a Labels:map[]}, <nil>
+}
+
+func ExampleBinder_EmptyStringAsZero() {
+	type Form struct {
+		Age    int     `json:"age"`
+		Score  float64 `json:"score"`
+		Agreed bool    `json:"agreed"`
+	}
+
+	empty := map[string]interface{}{"age": "", "score": "", "agreed": ""}
+	blank := map[string]interface{}{"age": "  ", "score": " ", "agreed": "\t"}
+
+	// By default, the empty string is bound to zero, but the blank one fails.
+	var f Form
+	fmt.Println(NewBinder().Bind(&f, empty))
+	fmt.Println(NewBinder().Bind(&f, map[string]interface{}{"age": "  "}))
+	fmt.Println(NewBinder().Bind(&f, map[string]interface{}{"score": " "}))
+	fmt.Println(NewBinder().Bind(&f, map[string]interface{}{"agreed": "\t"}))
+
+	// In Strict mode, even the empty string fails.
+	fmt.Println(NewBinder(WithStrict()).Bind(&f, empty))
+
+	// With EmptyStringAsZero, both are bound to zero, even in Strict mode.
+	for _, src := range []map[string]interface{}{empty, blank} {
+		f = Form{Age: 18, Score: 90, Agreed: true}
+		err := NewBinder(WithEmptyStringAsZero()).Bind(&f, src)
+		fmt.Printf("%+v, %v\n", f, err)
+
+		f = Form{Age: 18, Score: 90, Agreed: true}
+		err = NewBinder(WithEmptyStringAsZero(), WithStrict()).Bind(&f, src)
+		fmt.Printf("%+v, %v\n", f, err)
+	}
+
+	// Output:
+	// <nil>
+	// path "age": strconv.ParseInt: parsing "  ": invalid syntax
+	// path "score": strconv.ParseFloat: parsing " ": invalid syntax
+	// path "agreed": strconv.ParseBool: parsing "\t": invalid syntax
+	// path "age": cannot bind string to int in strict mode
+	// {Age:0 Score:0 Agreed:false}, <nil>
+	// {Age:0 Score:0 Agreed:false}, <nil>
+	// {Age:0 Score:0 Agreed:false}, <nil>
+	// {Age:0 Score:0 Agreed:false}, <nil>
+}
+
+func ExampleBinder_TrimSpace() {
+	var S struct {
+		Name    string            `json:"name"`
+		Age     int               `json:"age"`
+		Enabled bool              `json:"enabled"`
+		Timeout time.Duration     `json:"timeout"`
+		Tags    []string          `json:"tags"`
+		Labels  map[string]string `json:"labels"`
+	}
+
+	binder := NewBinder(WithTrimSpace())
+	err := binder.Bind(&S, map[string]interface{}{
+		"name":    "  Aaron ",
+		"age":     " 12 ",
+		"enabled": " true",
+		"timeout": "1s ",
+		"tags":    []string{" a", "b "},
+		"labels":  map[string]string{" key ": " value "},
+	})
+
+	fmt.Println(err)
+	fmt.Printf("%q %d %v %s %q %q\n", S.Name, S.Age, S.Enabled, S.Timeout, S.Tags, S.Labels)
+
+	// Output:
+	// <nil>
+	// "Aaron" 12 true 1s ["a" "b"] map[" key ":"value"]
+}
+
+func ExampleBinder_BoolTrueValues() {
+	binder := NewBinder(WithBoolValues(
+		[]string{"on", "yes", "y", "enabled"},
+		[]string{"off", "no", "n", "disabled"},
+	))
+
+	for _, src := range []string{"on", "YES", "y", "Enabled", "off", "No", "disabled", "true", "0", "maybe"} {
+		var v bool
+		if err := binder.Bind(&v, src); err != nil {
+			fmt.Println(err)
+		} else {
+			fmt.Printf("%s => %v\n", src, v)
+		}
+	}
+
+	// Output:
+	// on => true
+	// YES => true
+	// y => true
+	// Enabled => true
+	// off => false
+	// No => false
+	// disabled => false
+	// true => true
+	// 0 => false
+	// strconv.ParseBool: parsing "maybe": invalid syntax
+}
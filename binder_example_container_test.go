@@ -15,8 +15,12 @@
 package binder
 
 import (
+	"errors"
 	"fmt"
 	"net/url"
+	"reflect"
+	"strconv"
+	"strings"
 )
 
 func ExampleBinder_Container() {
@@ -68,3 +72,617 @@ func ExampleBinder_Container() {
 	// Structs[0]: Ints=[21 22], Query=map[k20:[v21 v22] k30:[v31 v32]]
 	// Structs[1]: Ints=[31 32], Query=map[k40:[v40]]
 }
+
+func ExampleBinder_ZeroFields() {
+	type Struct struct {
+		Array  [3]int             `json:"array"`
+		Maps   map[string]string  `json:"maps"`
+		Embed  struct{ A, B int } `json:"embed"`
+		Remain string             `json:"remain"`
+	}
+
+	newStruct := func() Struct {
+		var s Struct
+		s.Array = [3]int{1, 2, 3}
+		s.Maps = map[string]string{"k1": "v1"}
+		s.Embed.A, s.Embed.B = 1, 2
+		s.Remain = "remain"
+		return s
+	}
+
+	maps := map[string]interface{}{
+		"array": []int{4},
+		"maps":  map[string]string{"k2": "v2"},
+		"embed": map[string]interface{}{"A": 3},
+	}
+
+	for _, zero := range []bool{false, true} {
+		binder := NewBinder()
+		binder.ZeroFields = zero
+
+		s := newStruct()
+		if err := binder.Bind(&s, maps); err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Printf("ZeroFields=%v: %+v\n", zero, s)
+	}
+
+	// Output:
+	// ZeroFields=false: {Array:[4 2 3] Maps:map[k2:v2] Embed:{A:3 B:2} Remain:remain}
+	// ZeroFields=true: {Array:[4 0 0] Maps:map[k2:v2] Embed:{A:3 B:0} Remain:remain}
+}
+
+func ExampleBindError() {
+	var S struct {
+		Structs []struct {
+			Query map[string][]int `json:"query"`
+		} `json:"structs"`
+	}
+
+	maps := map[string]interface{}{
+		"structs": []map[string]interface{}{
+			{"query": map[string][]string{"k20": {"1", "2"}}},
+			{"query": map[string][]string{"k40": {"3", "x"}}},
+		},
+	}
+
+	err := Bind(&S, maps)
+	fmt.Println(err)
+
+	var be *BindError
+	if errors.As(err, &be) {
+		fmt.Printf("Path=%s, Kind=%s, Source=%v\n", be.Path, be.Kind, be.Source)
+	}
+
+	fmt.Println(errors.Is(err, ErrConvert), errors.Is(err, strconv.ErrSyntax))
+
+	var ch chan int
+	err = Bind(&ch, 1)
+	fmt.Println(errors.Is(err, ErrUnsupported), errors.Is(err, ErrConvert))
+
+	// Output:
+	// path "structs[1].query.k40[1]": strconv.ParseInt: parsing "x": invalid syntax
+	// Path=structs[1].query.k40[1], Kind=int, Source=x
+	// true true
+	// true false
+}
+
+func ExampleBinder_MergeMaps() {
+	config := struct {
+		Options map[string]interface{} `json:"options"`
+		Limits  map[string]int         `json:"limits"`
+	}{
+		Options: map[string]interface{}{
+			"debug": false,
+			"db":    map[string]interface{}{"host": "localhost", "port": 5432},
+		},
+		Limits: map[string]int{"cpu": 1, "mem": 512},
+	}
+
+	overrides := map[string]interface{}{
+		"options": map[string]interface{}{
+			"debug": true,
+			"db":    map[string]interface{}{"host": "db.example.com"},
+		},
+		"limits": map[string]string{"mem": "1024", "disk": "10"},
+	}
+
+	binder := NewBinder()
+	binder.MergeMaps = true
+	if err := binder.Bind(&config, overrides); err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	fmt.Printf("Options: %v\n", config.Options)
+	fmt.Printf("Limits: %v\n", config.Limits)
+
+	// Output:
+	// Options: map[db:map[host:db.example.com port:5432] debug:true]
+	// Limits: map[cpu:1 disk:10 mem:1024]
+}
+
+func ExampleBinder_AppendSlices() {
+	var S struct {
+		Ints  []int    `json:"ints"`
+		Strs  []string `json:"strs"`
+		Array [3]int   `json:"array"`
+	}
+
+	binder := NewBinder()
+	binder.AppendSlices = true
+	for _, src := range []map[string]interface{}{
+		{"ints": []string{"1", "2"}, "strs": []string{"a"}, "array": []int{1}},
+		{"ints": []int{3}, "strs": []string{"b", "c"}, "array": []int{2, 3}},
+	} {
+		if err := binder.Bind(&S, src); err != nil {
+			fmt.Println(err)
+			return
+		}
+	}
+
+	fmt.Printf("Ints=%v, Strs=%v, Array=%v\n", S.Ints, S.Strs, S.Array)
+
+	// Output:
+	// Ints=[1 2 3], Strs=[a b c], Array=[2 3 0]
+}
+
+func ExampleBinder_NullAsNil() {
+	type Patch struct {
+		Name *string           `json:"name"`
+		Age  *int              `json:"age"`
+		Tags []string          `json:"tags"`
+		Meta map[string]string `json:"meta"`
+	}
+
+	name, age := "Aaron", 18
+	newPatch := func() Patch {
+		return Patch{Name: &name, Age: &age, Tags: []string{"a"}, Meta: map[string]string{"k": "v"}}
+	}
+
+	// The JSON body: {"name": null, "tags": null}
+	src := map[string]interface{}{"name": nil, "tags": nil}
+
+	p1 := newPatch()
+	if err := NewBinder().Bind(&p1, src); err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Printf("Default:   Name=%v, Age=%v, Tags=%v, Meta=%v\n", p1.Name != nil, *p1.Age, p1.Tags, p1.Meta)
+
+	binder := NewBinder()
+	binder.NullAsNil = true
+
+	p2 := newPatch()
+	if err := binder.Bind(&p2, src); err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Printf("NullAsNil: Name=%v, Age=%v, Tags=%v, Meta=%v\n", p2.Name != nil, *p2.Age, p2.Tags, p2.Meta)
+
+	// Output:
+	// Default:   Name=true, Age=18, Tags=[a], Meta=map[k:v]
+	// NullAsNil: Name=false, Age=18, Tags=[], Meta=map[k:v]
+}
+
+func ExampleBinder_MaxDepth() {
+	// Build a deeply nested map: {"next": {"next": {...}}}
+	src := map[string]interface{}{"value": 0}
+	for i := 1; i <= 100; i++ {
+		src = map[string]interface{}{"value": i, "next": src}
+	}
+
+	var dst interface{}
+	binder := NewBinder()
+	binder.MaxDepth = 10
+	err := binder.Bind(&dst, src)
+	fmt.Println(err == nil)
+
+	var maps map[string]interface{}
+	err = binder.Bind(&maps, map[string]map[string]map[string]int{"a": {"b": {"c": 1}}})
+	fmt.Println(maps, err)
+
+	type Node struct {
+		Value int   `json:"value"`
+		Next  *Node `json:"next"`
+	}
+
+	var node Node
+	err = binder.Bind(&node, src)
+	fmt.Println(errors.Is(err, ErrMaxDepth))
+	fmt.Println(err)
+
+	// Output:
+	// true
+	// map[a:map[b:map[c:1]]] <nil>
+	// true
+	// path "next.next.next.next.next.next.next.next.next.next.value": max bind depth exceeded
+}
+
+func ExampleBinder_cycle() {
+	type Node struct {
+		Value int   `json:"value"`
+		Next  *Node `json:"next"`
+	}
+
+	// The shared map without a cycle is bound normally.
+	shared := map[string]interface{}{"value": 2}
+	var nodes [2]Node
+	err := NewBinder().Bind(&nodes, []interface{}{shared, shared})
+	fmt.Println(nodes[0].Value, nodes[1].Value, err)
+
+	// The map that refers to itself.
+	src := map[string]interface{}{"value": 1}
+	src["next"] = src
+
+	var node Node
+	err = NewBinder().Bind(&node, src)
+	fmt.Println(err)
+
+	// Output:
+	// 2 2 <nil>
+	// path "next": cycle detected in the source
+}
+
+type weekday int
+
+func ExampleBinder_mapKeys() {
+	var ints map[int]string
+	err := NewBinder().Bind(&ints, map[string]interface{}{"1": "a", "2": "b"})
+	fmt.Println(ints, err)
+
+	var days map[weekday]int
+	err = NewBinder().Bind(&days, map[string]string{"0": "1", "6": "2"})
+	fmt.Println(days, err)
+
+	var S struct {
+		Uints map[uint16]bool `json:"uints"`
+	}
+	err = NewBinder().Bind(&S, map[string]interface{}{
+		"uints": map[string]interface{}{"1": true, "x": false},
+	})
+	fmt.Println(err)
+
+	// Output:
+	// map[1:a 2:b] <nil>
+	// map[0:1 6:2] <nil>
+	// path "uints.x": invalid map key 'x': strconv.ParseUint: parsing "x": invalid syntax
+}
+
+func ExampleBinder_ArrayStrictLength() {
+	var ip [4]byte
+	coercions, err := NewBinder().BindWithCoercions(&ip, []int{127, 0, 0, 1, 80})
+	fmt.Println(ip, err)
+	for _, c := range coercions {
+		if c.Lossy {
+			fmt.Printf("%s => %s, lossy=%v\n", c.SrcType, c.DstType, c.Lossy)
+		}
+	}
+
+	binder := NewBinder()
+	binder.ArrayStrictLength = true
+	fmt.Println(binder.Bind(&ip, []int{127, 0, 0, 1, 80}))
+	fmt.Println(binder.Bind(&ip, []int{10, 0, 0}))
+	fmt.Println(binder.Bind(&ip, []int{10, 0, 0, 1}), ip)
+
+	// Output:
+	// [127 0 0 1] <nil>
+	// []int => [4]uint8, lossy=true
+	// the source length 5 does not match the array length 4
+	// the source length 3 does not match the array length 4
+	// <nil> [10 0 0 1]
+}
+
+func ExampleBinder_CopyAssignable() {
+	type Config struct {
+		Labels  map[string]string      `json:"labels"`
+		Ports   []int                  `json:"ports"`
+		Options map[string]interface{} `json:"options"`
+	}
+
+	newSource := func() map[string]interface{} {
+		return map[string]interface{}{
+			"labels":  map[string]string{"env": "prod"},
+			"ports":   []int{80, 443},
+			"options": map[string]interface{}{"tags": []string{"a"}},
+		}
+	}
+
+	for _, binder := range []Binder{NewBinder(), NewBinder(WithCopyAssignable())} {
+		var c Config
+		src := newSource()
+		if err := binder.Bind(&c, src); err != nil {
+			fmt.Println(err)
+			return
+		}
+
+		// Mutate the source after binding.
+		src["labels"].(map[string]string)["env"] = "test"
+		src["ports"].([]int)[0] = 8080
+		src["options"].(map[string]interface{})["tags"].([]string)[0] = "b"
+
+		fmt.Printf("CopyAssignable=%v: Labels=%v, Ports=%v, Options=%v\n",
+			binder.CopyAssignable, c.Labels, c.Ports, c.Options)
+	}
+
+	// Output:
+	// CopyAssignable=false: Labels=map[env:test], Ports=[8080 443], Options=map[tags:[b]]
+	// CopyAssignable=true: Labels=map[env:prod], Ports=[80 443], Options=map[tags:[a]]
+}
+
+func ExampleBinder_mapOfStrings() {
+	var S struct {
+		Form  map[string][]string `json:"form"`
+		Query url.Values          `json:"query"`
+	}
+
+	maps := map[string]interface{}{
+		"form": map[string]interface{}{
+			"k10": []string{"v11", "v12"},
+			"k20": []interface{}{"v21", 22}, // Such as decoded from JSON
+		},
+		"query": map[string]interface{}{
+			"k30": []string{"v31"},
+			"k40": []int{41, 42},
+		},
+	}
+
+	err := Bind(&S, maps)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	fmt.Printf("Form: %v\n", S.Form)
+	fmt.Printf("Query: %v\n", S.Query)
+
+	// Output:
+	// Form: map[k10:[v11 v12] k20:[v21 22]]
+	// Query: map[k30:[v31] k40:[41 42]]
+}
+
+func ExampleBind_dive() {
+	type Item struct {
+		Name string `json:"name"`
+	}
+
+	type Order struct {
+		Items  []Item     `json:"items" binder:"required,dive,required"`
+		Tags   []string   `json:"tags" binder:"dive,omitempty"`
+		Matrix [][]string `json:"matrix" binder:"dive,dive,required"`
+	}
+
+	for _, src := range []map[string]interface{}{
+		{
+			"items":  []interface{}{map[string]interface{}{"name": "a"}},
+			"tags":   []string{"x", "", "y"},
+			"matrix": [][]string{{"a", "b"}, {}},
+		},
+		{"tags": []string{"x"}},
+		{"items": []interface{}{map[string]interface{}{"name": "a"}, map[string]interface{}{}}},
+		{"items": []interface{}{map[string]interface{}{"name": "a"}}, "matrix": [][]string{{"a", ""}}},
+	} {
+		var order Order
+		if err := Bind(&order, src); err != nil {
+			fmt.Println(err)
+		} else {
+			fmt.Printf("Items=%v, Tags=%q, Matrix=%q\n", order.Items, order.Tags, order.Matrix)
+		}
+	}
+
+	// Output:
+	// Items=[{a}], Tags=["x" "y"], Matrix=[["a" "b"] []]
+	// path "items": the field is required
+	// path "items[1]": the element is required
+	// path "matrix[0][1]": the element is required
+}
+
+func ExampleBind_mapOfMapsOfStructs() {
+	type Item struct {
+		Name  string `json:"name"`
+		Price int    `json:"price"`
+	}
+
+	var S struct {
+		Catalog map[string]map[string]Item   `json:"catalog"`
+		Stocks  map[string]map[string][]Item `json:"stocks"`
+	}
+
+	src := map[string]interface{}{
+		"catalog": map[string]interface{}{
+			"fruit": map[string]interface{}{
+				"apple":  map[string]interface{}{"name": "Apple", "price": "5"},
+				"banana": map[string]string{"name": "Banana", "price": "3"},
+			},
+			"drink": map[string]map[string]interface{}{
+				"tea": {"name": "Tea", "price": 10},
+			},
+		},
+		"stocks": map[string]interface{}{
+			"shop1": map[string]interface{}{
+				"fruit": []interface{}{map[string]interface{}{"name": "Apple", "price": 5}},
+			},
+		},
+	}
+
+	if err := Bind(&S, src); err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	fmt.Println(S.Catalog)
+	fmt.Println(S.Stocks)
+
+	err := Bind(&S, map[string]interface{}{
+		"catalog": map[string]interface{}{
+			"fruit": map[string]interface{}{"apple": map[string]interface{}{"price": "x"}},
+		},
+	})
+	fmt.Println(err)
+
+	// Output:
+	// map[drink:map[tea:{Tea 10}] fruit:map[apple:{Apple 5} banana:{Banana 3}]]
+	// map[shop1:map[fruit:[{Apple 5}]]]
+	// path "catalog.fruit.apple.price": strconv.ParseInt: parsing "x": invalid syntax
+}
+
+func ExampleBind_sliceOfPointers() {
+	type Item struct {
+		ID   int    `json:"id"`
+		Name string `json:"name"`
+	}
+
+	var order struct {
+		Items  []*Item          `json:"items"`
+		Array  [2]*Item         `json:"array"`
+		Nested map[string]*Item `json:"nested"`
+	}
+
+	err := BindStructToMap(&order, "json", map[string]interface{}{
+		"items": []map[string]interface{}{
+			{"id": 1, "name": "a"},
+			{"id": "2", "name": "b"},
+		},
+		"array": []interface{}{
+			map[string]string{"id": "3", "name": "c"},
+			nil, // Keep the element nil.
+		},
+		"nested": map[string]map[string]interface{}{
+			"x": {"id": 4, "name": "d"},
+		},
+	})
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	for _, item := range order.Items {
+		fmt.Printf("%+v\n", *item)
+	}
+	fmt.Printf("%+v %v\n", *order.Array[0], order.Array[1])
+	fmt.Printf("%+v\n", *order.Nested["x"])
+
+	// Output:
+	// {ID:1 Name:a}
+	// {ID:2 Name:b}
+	// {ID:3 Name:c} <nil>
+	// {ID:4 Name:d}
+}
+
+func ExampleBind_nestedSlices() {
+	var S struct {
+		Ints    [][]int    `json:"ints"`
+		Strings [][]string `json:"strings"`
+		Mixed   [][]int    `json:"mixed"`
+		Arrays  [2][]int   `json:"arrays"`
+		Triple  [][][]int  `json:"triple"`
+	}
+
+	err := BindStructToMap(&S, "json", map[string]interface{}{
+		"ints":    []interface{}{[]interface{}{1, 2}, []interface{}{3.0, "4"}},
+		"strings": []interface{}{[]interface{}{"a", 1}, []string{"b", "c"}},
+		"mixed":   []interface{}{[]string{"5", "6"}, []int64{7}, [1]uint{8}},
+		"arrays":  [][]string{{"9"}, {"10", "11"}},
+		"triple":  []interface{}{[]interface{}{[]string{"12"}, []interface{}{13}}},
+	})
+
+	fmt.Println(err)
+	fmt.Println(S.Ints, S.Strings, S.Mixed, S.Arrays, S.Triple)
+
+	err = BindStructToMap(&S, "json", map[string]interface{}{
+		"ints": []interface{}{[]interface{}{1}, []string{"2", "x"}},
+	})
+	fmt.Println(err)
+
+	// Output:
+	// <nil>
+	// [[1 2] [3 4]] [[a 1] [b c]] [[5 6] [7] [8]] [[9] [10 11]] [[[12] [13]]]
+	// path "ints[1][1]": strconv.ParseInt: parsing "x": invalid syntax
+}
+
+func ExampleBinder_ConvertSingleToSlice() {
+	var S struct {
+		Ints   []int    `json:"ints"`
+		Array  [2]int   `json:"array"`
+		Names  []string `json:"names"`
+		Others []int    `json:"others"`
+	}
+
+	src := map[string]interface{}{"ints": "5", "array": 6, "names": "a"}
+	err := NewBinder(WithConvertSingleToSlice(true)).Bind(&S, src)
+	fmt.Println(S.Ints, S.Array, S.Names, err)
+
+	err = NewBinder(WithConvertSingleToSlice(false)).Bind(&S, map[string]interface{}{"others": 7})
+	fmt.Println(err)
+
+	// Output:
+	// [5] [6 0] [a] <nil>
+	// path "others": cannot bind single value '7' to slice field of type []int; enable ConvertSingleToSlice
+}
+
+func ExampleBind_singleToSlice() {
+	// ConvertSingleToSlice is enabled by NewBinder and DefaultBinder.
+	var ints1, ints2 []int
+	err1 := Bind(&ints1, "5")
+	err2 := Bind(&ints2, 5)
+	fmt.Println(ints1, err1)
+	fmt.Println(ints2, err2)
+
+	// But not by the zero Binder.
+	var ints3 []int
+	err3 := Binder{}.Bind(&ints3, 5)
+	fmt.Println(ints3, err3)
+
+	// Output:
+	// [5] <nil>
+	// [5] <nil>
+	// [] cannot bind single value '5' to slice field of type []int; enable ConvertSingleToSlice
+}
+
+func ExampleBinder_MapToSlice() {
+	type Item struct {
+		Name  string
+		Price int
+	}
+
+	src := map[string]interface{}{
+		"b": map[string]interface{}{"Name": "banana", "Price": "2"},
+		"a": map[string]interface{}{"Name": "apple", "Price": 1},
+		"c": map[string]interface{}{"Name": "cherry", "Price": 3},
+	}
+
+	var items []Item
+	err := NewBinder(WithMapToSlice(true, true)).Bind(&items, src)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Printf("%+v\n", items)
+
+	var keys []string
+	err = NewBinder(WithMapKeysToSlice(true, true)).Bind(&keys, src)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Println(keys)
+
+	var ids []int
+	err = NewBinder(WithMapKeysToSlice(true, true)).Bind(&ids, map[int]bool{10: true, 2: true, 33: false})
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Println(ids)
+
+	// Output:
+	// [{Name:apple Price:1} {Name:banana Price:2} {Name:cherry Price:3}]
+	// [a b c]
+	// [2 10 33]
+}
+
+func ExampleBinder_mapOfStringsWithHook() {
+	var S struct {
+		Labels map[string]interface{} `json:"labels"`
+	}
+
+	// The hook must run on each value of map[string]string,
+	// which is not copied into map[string]interface{} directly.
+	// Only convert the map values, not the keys.
+	binder := NewBinderWithHook(func(dst reflect.Value, src interface{}) (interface{}, error) {
+		if s, ok := src.(string); ok && dst.Kind() == reflect.Interface {
+			return strings.ToUpper(s), nil
+		}
+		return src, nil
+	})
+
+	err := binder.Bind(&S, map[string]interface{}{
+		"labels": map[string]string{"env": "prod"},
+	})
+
+	fmt.Println(err, S.Labels)
+
+	// Output:
+	// <nil> map[env:PROD]
+}
@@ -15,9 +15,16 @@
 package binder
 
 import (
+	"context"
 	"fmt"
+	"math"
 	"mime/multipart"
 	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/xgfone/go-defaults"
 )
 
 func ExampleBinder_Hook() {
@@ -69,3 +76,415 @@ func ExampleBinder_Hook() {
 	// Files[0].Filename=file1
 	// Files[1].Filename=file2
 }
+
+func ExampleBinder_FieldResolver() {
+	// levenshtein returns the edit distance between the strings s1 and s2.
+	levenshtein := func(s1, s2 string) int {
+		prev := make([]int, len(s2)+1)
+		for j := range prev {
+			prev[j] = j
+		}
+
+		for i := 1; i <= len(s1); i++ {
+			curr := make([]int, len(s2)+1)
+			curr[0] = i
+			for j := 1; j <= len(s2); j++ {
+				cost := 1
+				if s1[i-1] == s2[j-1] {
+					cost = 0
+				}
+				curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+			}
+			prev = curr
+		}
+		return prev[len(s2)]
+	}
+
+	// Look up the source value by the nearest key within the distance 2.
+	resolver := func(sf reflect.StructField, src map[string]interface{}) (interface{}, bool) {
+		name, _ := defaults.GetStructFieldName(sf)
+
+		var nearest string
+		distance := 3
+		for key := range src {
+			if d := levenshtein(name, key); d < distance || (d == distance && key < nearest) {
+				nearest, distance = key, d
+			}
+		}
+
+		if distance > 2 {
+			return nil, false
+		}
+		return src[nearest], true
+	}
+
+	var dst struct {
+		UserName string `json:"username"`
+		Email    string `json:"email"`
+		Age      int    `json:"age"`
+	}
+
+	src := map[string]interface{}{
+		"user_name": "Aaron",
+		"e-mail":    "aaron@example.com",
+		"address":   "somewhere",
+	}
+
+	binder := NewBinder()
+	binder.FieldResolver = resolver
+	err := binder.Bind(&dst, src)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	fmt.Printf("UserName=%s\n", dst.UserName)
+	fmt.Printf("Email=%s\n", dst.Email)
+	fmt.Printf("Age=%d\n", dst.Age)
+
+	// Output:
+	// UserName=Aaron
+	// Email=aaron@example.com
+	// Age=0
+}
+
+func ExampleBinder_AfterField() {
+	var dst struct {
+		Name   string `json:"name"`
+		Status string `json:"status"`
+		Age    int    `json:"age"`
+	}
+
+	binder := NewBinder()
+	binder.AfterField = func(dst reflect.Value, field reflect.StructField, src interface{}) error {
+		fmt.Printf("bound %s from %q\n", field.Name, fmt.Sprint(src))
+		switch field.Name {
+		case "Name":
+			dst.SetString(strings.TrimSpace(dst.String()))
+		case "Status":
+			dst.SetString(strings.ToLower(dst.String()))
+		case "Age":
+			if dst.Int() < 0 {
+				return fmt.Errorf("invalid age %d", dst.Int())
+			}
+		}
+		return nil
+	}
+
+	src := map[string]interface{}{"name": "  Aaron ", "status": "ACTIVE", "age": 18}
+	if err := binder.Bind(&dst, src); err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Printf("Name=%q, Status=%s, Age=%d\n", dst.Name, dst.Status, dst.Age)
+
+	err := binder.Bind(&dst, map[string]interface{}{"age": -1})
+	fmt.Println(err)
+
+	// Output:
+	// bound Name from "  Aaron "
+	// bound Status from "ACTIVE"
+	// bound Age from "18"
+	// Name="Aaron", Status=active, Age=18
+	// bound Age from "-1"
+	// path "age": invalid age -1
+}
+
+func ExampleComposeHooks() {
+	// Convert []*multipart.FileHeader to *multipart.FileHeader.
+	multiparthook := func(dst reflect.Value, src interface{}) (interface{}, error) {
+		if _, ok := dst.Interface().(*multipart.FileHeader); !ok {
+			return src, nil
+		}
+
+		if srcfiles, ok := src.([]*multipart.FileHeader); ok {
+			if len(srcfiles) == 0 {
+				return nil, nil
+			}
+			return srcfiles[0], nil
+		}
+		return src, nil
+	}
+
+	// Convert the decimal string like "12.34" to the cents.
+	type Cents int64
+	centshook := func(dst reflect.Value, src interface{}) (interface{}, error) {
+		if _, ok := dst.Interface().(Cents); !ok {
+			return src, nil
+		}
+
+		if s, ok := src.(string); ok {
+			yuan, cents, _ := strings.Cut(s, ".")
+			return yuan + (cents + "00")[:2], nil
+		}
+		return src, nil
+	}
+
+	src := map[string]interface{}{
+		"file":  []*multipart.FileHeader{{Filename: "file"}},
+		"price": "12.3",
+	}
+
+	var dst struct {
+		File  *multipart.FileHeader `json:"file"`
+		Price Cents                 `json:"price"`
+	}
+
+	binder := Binder{Hook: ComposeHooks(multiparthook, centshook)}
+	if err := binder.Bind(&dst, src); err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	fmt.Printf("File.Filename=%s\n", dst.File.Filename)
+	fmt.Printf("Price=%d\n", dst.Price)
+
+	// Output:
+	// File.Filename=file
+	// Price=1230
+}
+
+func ExampleHookFromConverters() {
+	type Celsius float64
+	type Upper string
+
+	hook := HookFromConverters(map[reflect.Type]func(interface{}) (interface{}, error){
+		reflect.TypeOf(Celsius(0)): func(src interface{}) (interface{}, error) {
+			if s, ok := src.(string); ok && strings.HasSuffix(s, "F") {
+				f, err := strconv.ParseFloat(strings.TrimSuffix(s, "F"), 64)
+				return (f - 32) * 5 / 9, err
+			}
+			return src, nil
+		},
+
+		reflect.TypeOf(Upper("")): func(src interface{}) (interface{}, error) {
+			if s, ok := src.(string); ok {
+				return strings.ToUpper(s), nil
+			}
+			return src, nil
+		},
+	})
+
+	var dst struct {
+		Temp  Celsius `json:"temp"`
+		Code  Upper   `json:"code"`
+		Other string  `json:"other"`
+	}
+
+	src := map[string]interface{}{"temp": "212F", "code": "abc", "other": "xyz"}
+	if err := NewBinderWithHook(hook).Bind(&dst, src); err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	fmt.Printf("Temp=%v, Code=%s, Other=%s\n", dst.Temp, dst.Code, dst.Other)
+
+	// Output:
+	// Temp=100, Code=ABC, Other=xyz
+}
+
+// Money is the money in cents.
+type Money int64
+
+// Set implements the interface Setter, which is overridden by the converters.
+func (m *Money) Set(src interface{}) error {
+	return fmt.Errorf("unexpected Setter for %T", src)
+}
+
+func ExampleBinder_Converters() {
+	moneyType := reflect.TypeOf(Money(0))
+	binder := NewBinder()
+	binder.Converters = map[ConvertKey]func(interface{}) (interface{}, error){
+		{From: reflect.TypeOf(""), To: moneyType}: func(src interface{}) (interface{}, error) {
+			s := strings.TrimPrefix(src.(string), "$")
+			f, err := strconv.ParseFloat(s, 64)
+			return Money(math.Round(f * 100)), err
+		},
+		{From: reflect.TypeOf(float64(0)), To: moneyType}: func(src interface{}) (interface{}, error) {
+			return Money(math.Round(src.(float64) * 100)), nil
+		},
+	}
+
+	var dst struct {
+		Price1 Money
+		Price2 Money
+		Price3 Money
+	}
+
+	src := map[string]interface{}{"Price1": "$12.34", "Price2": 5.6}
+	err := binder.Bind(&dst, src)
+	fmt.Println(dst.Price1, dst.Price2, err)
+
+	err = binder.Bind(&dst, map[string]interface{}{"Price3": 1})
+	fmt.Println(err)
+
+	// Output:
+	// 1234 560 <nil>
+	// path "Price3": unexpected Setter for int
+}
+
+func ExampleBinder_DisableTimeSpecialCase() {
+	var S struct {
+		Timeout time.Duration `json:"timeout"`
+		Start   time.Time     `json:"start"`
+	}
+
+	src := map[string]interface{}{"timeout": 1000, "start": "2023-01-02T03:04:05Z"}
+	if err := NewBinder().Bind(&S, src); err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Printf("Default:  Timeout=%s, Start=%s\n", S.Timeout, S.Start.Format(time.RFC3339))
+
+	binder := NewBinder()
+	binder.DisableTimeSpecialCase = true
+	binder.Converters = map[ConvertKey]func(interface{}) (interface{}, error){
+		{From: reflect.TypeOf(""), To: reflect.TypeOf(time.Time{})}: func(src interface{}) (interface{}, error) {
+			return time.Parse("20060102", src.(string))
+		},
+	}
+
+	src["start"] = "20240203"
+	if err := binder.Bind(&S, src); err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Printf("Disabled: Timeout=%s, Start=%s\n", S.Timeout, S.Start.Format(time.RFC3339))
+
+	// Output:
+	// Default:  Timeout=1s, Start=2023-01-02T03:04:05Z
+	// Disabled: Timeout=1µs, Start=2024-02-03T00:00:00Z
+}
+
+func ExampleBinder_FieldValidator() {
+	var dst struct {
+		Age  int    `json:"age" validate:"min(0)"`
+		Name string `json:"name" validate:"required"`
+	}
+
+	binder := NewBinder(WithFieldValidator(func(field reflect.StructField, value reflect.Value) error {
+		fmt.Printf("validate %s\n", field.Name)
+		switch field.Tag.Get("validate") {
+		case "min(0)":
+			if value.Int() < 0 {
+				return fmt.Errorf("the value %d is less than 0", value.Int())
+			}
+		case "required":
+			if value.IsZero() {
+				return fmt.Errorf("the value is required")
+			}
+		}
+		return nil
+	}))
+
+	err := binder.Bind(&dst, map[string]interface{}{"age": -1, "name": "Aaron"})
+	fmt.Println(err)
+
+	// Output:
+	// validate Age
+	// path "age": the value -1 is less than 0
+}
+
+func ExampleBinder_OnField() {
+	var dst struct {
+		Name    string `json:"name"`
+		Age     int    `json:"age"`
+		Address struct {
+			City   string `json:"city"`
+			Street string `json:"street"`
+		} `json:"address"`
+	}
+
+	binder := NewBinder(WithOnField(func(path string, field reflect.StructField, matched bool) {
+		fmt.Printf("%s: field=%s, matched=%v\n", path, field.Name, matched)
+	}))
+
+	src := map[string]interface{}{
+		"name":    "Aaron",
+		"address": map[string]interface{}{"city": "Beijing"},
+	}
+	if err := binder.Bind(&dst, src); err != nil {
+		fmt.Println(err)
+	}
+
+	// Output:
+	// name: field=Name, matched=true
+	// age: field=Age, matched=false
+	// address: field=Address, matched=true
+	// address.city: field=City, matched=true
+	// address.street: field=Street, matched=false
+}
+
+func ExampleBinder_PathHook() {
+	var config struct {
+		Name    string `json:"name"`
+		Secrets struct {
+			Password string `json:"password"`
+			Token    string `json:"token"`
+		} `json:"secrets"`
+	}
+
+	// decrypt is a fake decryption, which only reverses the string.
+	decrypt := func(s string) string {
+		runes := []rune(s)
+		for i, j := 0, len(runes)-1; i < j; i, j = i+1, j-1 {
+			runes[i], runes[j] = runes[j], runes[i]
+		}
+		return string(runes)
+	}
+
+	binder := NewBinder(WithPathHook(func(path string, dst reflect.Value, src interface{}) (interface{}, error) {
+		if s, ok := src.(string); ok && strings.HasPrefix(path, "secrets.") {
+			return decrypt(s), nil
+		}
+		return src, nil
+	}))
+
+	src := map[string]interface{}{
+		"name":    "drowssap",
+		"secrets": map[string]interface{}{"password": "drowssap", "token": "nekot"},
+	}
+	if err := binder.Bind(&config, src); err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	fmt.Println(config.Name)
+	fmt.Println(config.Secrets.Password)
+	fmt.Println(config.Secrets.Token)
+
+	// Output:
+	// drowssap
+	// password
+	// token
+}
+
+func ExampleBinder_BindContext() {
+	type ctxkey struct{}
+
+	var dst struct {
+		Name string `json:"name"`
+		Age  int    `json:"age"`
+	}
+
+	binder := NewBinder(WithContextHook(func(ctx context.Context, path string, dst reflect.Value, src interface{}) (interface{}, error) {
+		if s, ok := src.(string); ok && path == "name" {
+			return ctx.Value(ctxkey{}).(string) + s, nil
+		}
+		return src, nil
+	}))
+
+	ctx := context.WithValue(context.Background(), ctxkey{}, "Mr. ")
+	err := binder.BindContext(ctx, &dst, map[string]interface{}{"name": "Aaron", "age": 18})
+	fmt.Println(dst.Name, dst.Age, err)
+
+	ctx, cancel := context.WithCancel(ctx)
+	cancel()
+
+	err = binder.BindContext(ctx, &dst, map[string]interface{}{"name": "Bob", "age": 20})
+	fmt.Println(dst.Name, dst.Age, err)
+
+	// Output:
+	// Mr. Aaron 18 <nil>
+	// Mr. Aaron 18 context canceled
+}
@@ -15,8 +15,11 @@
 package binder
 
 import (
+	"database/sql"
+	"database/sql/driver"
 	"errors"
 	"fmt"
+	"net/url"
 	"strconv"
 	"strings"
 )
@@ -129,3 +132,234 @@ func ExampleBinder_Interface() {
 	// Interface5: any
 	// Interface6: Name=Xgfone, Age=20
 }
+
+func ExampleBinder_interfaceValue() {
+	var S = struct {
+		// The interface may also be set to a value, not a pointer,
+		// which is bound by its copy and replaced as a whole.
+		Stringer fmt.Stringer
+	}{
+		Stringer: Int(123),
+	}
+
+	err := Bind(&S, map[string]interface{}{"Stringer": "456"})
+	fmt.Printf("Stringer: %T(%v), err=%v\n", S.Stringer, S.Stringer, err)
+
+	// Output:
+	// Stringer: binder.Int(456), err=<nil>
+}
+
+// Cert is a customized type implementing encoding.TextUnmarshaler.
+type Cert struct{ Data string }
+
+// UnmarshalText implements the interface encoding.TextUnmarshaler.
+func (c *Cert) UnmarshalText(data []byte) error {
+	fmt.Printf("UnmarshalText: %s\n", data)
+	c.Data = string(data)
+	return nil
+}
+
+func ExampleBind_maxlen() {
+	var S struct {
+		Cert  Cert   `json:"cert,maxlen=8"`
+		Bytes []byte `json:"bytes,maxlen=4"`
+	}
+
+	err := Bind(&S, map[string]interface{}{"cert": "CERTDATA", "bytes": "abcd"})
+	fmt.Printf("Cert=%s, Bytes=%s, err=%v\n", S.Cert.Data, S.Bytes, err)
+
+	err = Bind(&S, map[string]interface{}{"cert": "LARGE-CERTDATA"})
+	fmt.Printf("err=%v\n", err)
+
+	err = Bind(&S, map[string]interface{}{"bytes": "abcde"})
+	fmt.Printf("err=%v\n", err)
+
+	// Output:
+	// UnmarshalText: CERTDATA
+	// Cert=CERTDATA, Bytes=abcd, err=<nil>
+	// err=path "cert": the source length 14 exceeds the max length 8
+	// err=path "bytes": the source length 5 exceeds the max length 4
+}
+
+func ExampleBindStructToURLValues_maxlen() {
+	var S struct {
+		Cert Cert `query:"cert,maxlen=4"`
+	}
+
+	err := BindStructToURLValues(&S, "query", url.Values{"cert": []string{"DATA"}})
+	fmt.Printf("Cert=%s, err=%v\n", S.Cert.Data, err)
+
+	err = BindStructToURLValues(&S, "query", url.Values{"cert": []string{"LARGE-DATA"}})
+	fmt.Printf("err=%v\n", err)
+
+	// Output:
+	// UnmarshalText: DATA
+	// Cert=DATA, err=<nil>
+	// err=path "cert": the source length 10 exceeds the max length 4
+}
+
+func ExampleBind_sqlNull() {
+	var Model struct {
+		Name   sql.NullString  `json:"name"`
+		Age    sql.NullInt64   `json:"age"`
+		Score  sql.NullFloat64 `json:"score"`
+		Active sql.NullBool    `json:"active"`
+		Email  sql.NullString  `json:"email"`
+		Phone  *sql.NullString `json:"phone"`
+	}
+
+	err := Bind(&Model, url.Values{
+		"name":   []string{"Aaron"},
+		"age":    []string{"18"},
+		"score":  []string{"95.5"},
+		"active": []string{"true"},
+		"phone":  []string{"123456"},
+	})
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	fmt.Printf("Name: %+v\n", Model.Name)
+	fmt.Printf("Age: %+v\n", Model.Age)
+	fmt.Printf("Score: %+v\n", Model.Score)
+	fmt.Printf("Active: %+v\n", Model.Active)
+	fmt.Printf("Email: %+v\n", Model.Email)
+	fmt.Printf("Phone: %+v\n", *Model.Phone)
+
+	err = Bind(&Model, map[string]interface{}{"age": "abc"})
+	fmt.Println(err)
+
+	// Output:
+	// Name: {String:Aaron Valid:true}
+	// Age: {Int64:18 Valid:true}
+	// Score: {Float64:95.5 Valid:true}
+	// Active: {Bool:true Valid:true}
+	// Email: {String: Valid:false}
+	// Phone: {String:123456 Valid:true}
+	// path "age": converting driver.Value type string ("abc") to a int64: invalid syntax
+}
+
+// Level is a customized type implementing sql.Scanner.
+type Level int
+
+func (l *Level) Scan(src interface{}) error {
+	switch v := src.(type) {
+	case string:
+		switch strings.ToLower(v) {
+		case "low":
+			*l = 1
+		case "high":
+			*l = 2
+		default:
+			return fmt.Errorf("unknown level '%s'", v)
+		}
+	case int64:
+		*l = Level(v)
+	default:
+		return fmt.Errorf("unsupported level type %T", src)
+	}
+	return nil
+}
+
+// Email is a named string implementing driver.Valuer and fmt.Stringer,
+// but not sql.Scanner, encoding.TextUnmarshaler, Setter or Unmarshaler.
+type Email string
+
+func (e Email) Value() (driver.Value, error) { return strings.ToLower(string(e)), nil }
+func (e Email) String() string               { return "<" + string(e) + ">" }
+
+func ExampleBind_valuer() {
+	var S struct {
+		Email  Email   `json:"email"`
+		Emails []Email `json:"emails"`
+		Ptr    *Email  `json:"ptr"`
+	}
+
+	err := BindStructToMap(&S, "json", map[string]interface{}{
+		"email":  "Aaron@example.com",
+		"emails": []string{"a@example.com", "b@example.com"},
+		"ptr":    "c@example.com",
+	})
+
+	fmt.Println(err)
+	fmt.Println(S.Email, S.Emails, S.Ptr.String())
+
+	// Output:
+	// <nil>
+	// <Aaron@example.com> [<a@example.com> <b@example.com>] <c@example.com>
+}
+
+func ExampleBinder_DisableScanner() {
+	var levels [3]Level
+	err := Bind(&levels, []interface{}{"HIGH", 1, int8(2)})
+	fmt.Println(levels, err)
+
+	// uint is converted to int64 to be scanned.
+	var level Level
+	err = Bind(&level, []uint{3})
+	fmt.Println(level, err)
+
+	err = NewBinder(WithDisableScanner()).Bind(&level, "high")
+	fmt.Println(err)
+
+	// Output:
+	// [2 1 2] <nil>
+	// 3 <nil>
+	// strconv.ParseInt: parsing "high": invalid syntax
+}
+
+func ExampleBinder_IgnoreSetterUnmarshaler() {
+	src := map[string]string{"Name": "Aaron", "Age": "18"}
+
+	var s1 Struct
+	err := NewBinder().Bind(&s1, src)
+	fmt.Printf("%v, err=%v\n", s1, err)
+
+	var s2 Struct
+	err = NewBinder(WithIgnoreSetterUnmarshaler()).Bind(&s2, src)
+	fmt.Printf("%v, err=%v\n", s2, err)
+
+	// Output:
+	// Name=, Age=0, err=unsupport to convert map[string]string to a struct
+	// Name=Aaron, Age=18, err=<nil>
+}
+
+// Version implements both Unmarshaler and Setter.
+type Version struct{ Major, Minor int }
+
+// UnmarshalBind implements the interface Unmarshaler,
+// which only supports the map source like {"major": 1, "minor": 2}.
+func (v *Version) UnmarshalBind(src interface{}) (err error) {
+	maps, ok := src.(map[string]interface{})
+	if !ok {
+		return fmt.Errorf("unsupport to unmarshal %T to Version", src)
+	}
+	v.Major, v.Minor = maps["major"].(int), maps["minor"].(int)
+	return
+}
+
+// Set implements the interface Setter,
+// which only supports the string source like "1.2".
+func (v *Version) Set(src interface{}) (err error) {
+	s, ok := src.(string)
+	if !ok {
+		return fmt.Errorf("unsupport to set %T to Version", src)
+	}
+	_, err = fmt.Sscanf(s, "%d.%d", &v.Major, &v.Minor)
+	return
+}
+
+func ExampleBinder_PreferSetter() {
+	var v1 Version
+	err := NewBinder().Bind(&v1, "1.2")
+	fmt.Printf("%v, err=%v\n", v1, err)
+
+	var v2 Version
+	err = NewBinder(WithPreferSetter()).Bind(&v2, "1.2")
+	fmt.Printf("%v, err=%v\n", v2, err)
+
+	// Output:
+	// {0 0}, err=unsupport to unmarshal string to Version
+	// {1 2}, err=<nil>
+}
@@ -15,7 +15,11 @@
 package binder
 
 import (
+	"encoding/base64"
 	"fmt"
+	"net"
+	"net/netip"
+	"net/url"
 	"time"
 
 	"github.com/xgfone/go-defaults"
@@ -185,3 +189,700 @@ func ExampleBinder_Struct() {
 	// Squash.Field2=52
 	// Ignore=
 }
+
+// Amount is a named numeric type.
+type Amount float64
+
+func ExampleBind_asString() {
+	var S struct {
+		ID1 string `json:"id1,asString"`
+		ID2 string `json:"id2,asString"`
+		ID3 string `json:"id3,asString"`
+		ID4 string `json:"id4,asString"`
+	}
+
+	maps := map[string]interface{}{
+		"id1": Amount(1e21),
+		"id2": int64(1234567890123456789),
+		"id3": "abc",
+		"id4": float32(0.1),
+	}
+
+	err := Bind(&S, maps)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	fmt.Printf("ID1=%s\n", S.ID1)
+	fmt.Printf("ID2=%s\n", S.ID2)
+	fmt.Printf("ID3=%s\n", S.ID3)
+	fmt.Printf("ID4=%s\n", S.ID4)
+
+	// Without asString, the named numeric type cannot be bound to string.
+	var T struct {
+		ID1 string `json:"id1"`
+	}
+	fmt.Println(Bind(&T, map[string]interface{}{"id1": Amount(1e21)}))
+
+	// Output:
+	// ID1=1000000000000000000000
+	// ID2=1234567890123456789
+	// ID3=abc
+	// ID4=0.1
+	// path "id1": unsupport to convert binder.Amount to string
+}
+
+func ExampleBind_base64() {
+	type Payload struct {
+		ID    int      `json:"id"`
+		Items []string `json:"items"`
+	}
+
+	var envelope struct {
+		Type    string            `json:"type"`
+		Payload *Payload          `json:"payload,base64"`
+		Meta    map[string]string `json:"meta,base64"`
+		Data    []byte            `json:"data,base64"`
+		Text    string            `json:"text,base64"`
+	}
+
+	encode := func(s string) string { return base64.StdEncoding.EncodeToString([]byte(s)) }
+	src := map[string]interface{}{
+		"type":    "order",
+		"payload": encode(`{"id": 123, "items": ["a", "b"]}`),
+		"meta":    encode(`{"source": "api"}`),
+		"data":    encode("raw"),
+		"text":    encode("hello"),
+	}
+
+	if err := BindStructToMap(&envelope, "json", src); err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	fmt.Println(envelope.Type, *envelope.Payload, envelope.Meta)
+	fmt.Println(string(envelope.Data), envelope.Text)
+
+	err := BindStructToMap(&envelope, "json", map[string]interface{}{"payload": "!!!"})
+	fmt.Println(err)
+
+	err = BindStructToMap(&envelope, "json", map[string]interface{}{"payload": encode("{")})
+	fmt.Println(err)
+
+	// Output:
+	// order {123 [a b]} map[source:api]
+	// raw hello
+	// path "payload": invalid base64 source: illegal base64 data at input byte 0
+	// path "payload": invalid JSON in base64 source: unexpected end of JSON input
+}
+
+func ExampleBind_omitempty() {
+	// Pre-populate the struct with the existing values.
+	S := struct {
+		Name  string   `json:"name,omitempty"`
+		Age   int      `json:"age,omitempty"`
+		Tags  []string `json:"tags,omitempty"`
+		Email string   `json:"email"`
+	}{
+		Name:  "Aaron",
+		Age:   18,
+		Tags:  []string{"a", "b"},
+		Email: "aaron@example.com",
+	}
+
+	maps := map[string]interface{}{
+		"name":  "",
+		"age":   20,
+		"tags":  []string{},
+		"email": "",
+	}
+
+	err := Bind(&S, maps)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	fmt.Printf("Name=%s\n", S.Name)
+	fmt.Printf("Age=%d\n", S.Age)
+	fmt.Printf("Tags=%v\n", S.Tags)
+	fmt.Printf("Email=%s\n", S.Email)
+
+	// Output:
+	// Name=Aaron
+	// Age=20
+	// Tags=[a b]
+	// Email=
+}
+
+func ExampleBinder_CaseInsensitive() {
+	var S struct {
+		UserName string `json:"userName"`
+		Email    string `json:"email"`
+		Age      int    `json:"age"`
+	}
+
+	maps := map[string]interface{}{
+		"username": "aaron", // Match userName case-insensitively.
+		"EMAIL":    "Aaron@Example.com",
+		"Email":    "aaron@example.com", // "EMAIL" < "Email", so use "EMAIL".
+		"age":      18,                  // Match exactly.
+		"AGE":      20,
+	}
+
+	binder := NewBinder()
+	binder.CaseInsensitive = true
+	err := binder.Bind(&S, maps)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	fmt.Printf("UserName=%s\n", S.UserName)
+	fmt.Printf("Email=%s\n", S.Email)
+	fmt.Printf("Age=%d\n", S.Age)
+
+	// Output:
+	// UserName=aaron
+	// Email=Aaron@Example.com
+	// Age=18
+}
+
+func ExampleBindWithTags() {
+	var S struct {
+		JSONOnly string `json:"json_only"`
+		FormOnly string `form:"form_only"`
+		Both     string `form:"both_form" json:"both_json"`
+		FormSkip string `form:"-" json:"form_skip"`
+		AllSkip  string `form:"-" json:"-"`
+		NoTag    string
+	}
+
+	maps := map[string]interface{}{
+		"json_only": "a",
+		"form_only": "b",
+		"both_form": "c",
+		"both_json": "d",
+		"form_skip": "e",
+		"AllSkip":   "f",
+		"NoTag":     "g",
+	}
+
+	err := BindWithTags(&S, maps, "form", "json")
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	fmt.Printf("JSONOnly=%s\n", S.JSONOnly)
+	fmt.Printf("FormOnly=%s\n", S.FormOnly)
+	fmt.Printf("Both=%s\n", S.Both)
+	fmt.Printf("FormSkip=%s\n", S.FormSkip)
+	fmt.Printf("AllSkip=%s\n", S.AllSkip)
+	fmt.Printf("NoTag=%s\n", S.NoTag)
+
+	// Output:
+	// JSONOnly=a
+	// FormOnly=b
+	// Both=c
+	// FormSkip=e
+	// AllSkip=
+	// NoTag=g
+}
+
+func ExampleBinder_TagName() {
+	var S struct {
+		Name string `form:"name"`
+		Age  int    `form:"age"`
+	}
+
+	maps := map[string]interface{}{"name": "Aaron", "age": "18"}
+	err := Binder{TagName: "form"}.Bind(&S, maps)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	fmt.Printf("Name=%s\n", S.Name)
+	fmt.Printf("Age=%d\n", S.Age)
+
+	// Output:
+	// Name=Aaron
+	// Age=18
+}
+
+func ExampleBinder_CollectErrors() {
+	var S struct {
+		Int   int     `json:"int"`
+		Uint  uint    `json:"uint"`
+		Str   string  `json:"str"`
+		Float float64 `json:"float"`
+		Embed struct {
+			Bool bool `json:"bool"`
+		} `json:"embed"`
+	}
+
+	maps := map[string]interface{}{
+		"int":   "a",
+		"uint":  "1",
+		"str":   "abc",
+		"float": "b",
+		"embed": map[string]interface{}{"bool": "c"},
+	}
+
+	binder := NewBinder()
+	binder.CollectErrors = true
+	err := binder.Bind(&S, maps)
+	fmt.Println(err)
+	fmt.Printf("Uint=%d, Str=%s\n", S.Uint, S.Str)
+
+	err = binder.Bind(&S, map[string]interface{}{"int": 1})
+	fmt.Println(err)
+
+	// Output:
+	// path "int": strconv.ParseInt: parsing "a": invalid syntax
+	// path "float": strconv.ParseFloat: parsing "b": invalid syntax
+	// path "embed.bool": strconv.ParseBool: parsing "c": invalid syntax
+	// Uint=1, Str=abc
+	// <nil>
+}
+
+func ExampleBinder_FlattenSeparator() {
+	var config struct {
+		Name string `json:"name"`
+		DB   struct {
+			Host string `json:"host"`
+			Port int    `json:"port"`
+			Pool struct {
+				Size int `json:"size"`
+			} `json:"pool"`
+		} `json:"db"`
+	}
+
+	src := map[string]string{
+		"name":         "app",
+		"db.host":      "localhost",
+		"db.port":      "5432",
+		"db.pool.size": "10",
+	}
+
+	binder := NewBinder()
+	binder.FlattenSeparator = "."
+	if err := binder.Bind(&config, src); err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Printf("%+v\n", config)
+
+	err := binder.Bind(&config, map[string]string{"db": "x", "db.host": "y"})
+	fmt.Println(err)
+
+	// The nested map of the source is merged, but not modified.
+	db := map[string]interface{}{"port": 3306}
+	err = binder.Bind(&config, map[string]interface{}{"db": db, "db.host": "127.0.0.1"})
+	fmt.Println(err, config.DB.Host, config.DB.Port, db)
+
+	// Output:
+	// {Name:app DB:{Host:localhost Port:5432 Pool:{Size:10}}}
+	// key 'db.host' collides with the scalar key 'db'
+	// <nil> 127.0.0.1 3306 map[port:3306]
+}
+
+func ExampleBind_embeddedPointer() {
+	type Base struct {
+		ID      int64  `json:"id"`
+		Creator string `json:"creator"`
+	}
+
+	type Meta struct {
+		Version int `json:"version"`
+	}
+
+	type T struct {
+		*Base
+		Meta  *Meta  `json:"meta,squash"`
+		Extra string `json:"extra"`
+	}
+
+	var t T
+	err := Bind(&t, map[string]interface{}{"id": 123, "creator": "Aaron", "version": 2, "extra": "abc"})
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	fmt.Printf("ID=%d, Creator=%s, Version=%d, Extra=%s\n", t.ID, t.Creator, t.Meta.Version, t.Extra)
+
+	// Output:
+	// ID=123, Creator=Aaron, Version=2, Extra=abc
+}
+
+func ExampleBind_squashPrefix() {
+	type Address struct {
+		City   string `json:"city"`
+		Street string `json:"street"`
+	}
+
+	type Order struct {
+		City     string  `json:"city"`
+		Shipping Address `json:",squash=shipping_"`
+		Billing  Address `json:",squash=billing_"`
+	}
+
+	src := map[string]interface{}{
+		"city":             "Shanghai",
+		"shipping_city":    "Beijing",
+		"shipping_street":  "Street 1",
+		"billing_city":     "Shenzhen",
+		"billing_street":   "Street 2",
+		"billing_district": "ignored",
+	}
+
+	var order Order
+	if err := Bind(&order, src); err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	fmt.Printf("City: %s\n", order.City)
+	fmt.Printf("Shipping: %+v\n", order.Shipping)
+	fmt.Printf("Billing: %+v\n", order.Billing)
+
+	// Output:
+	// City: Shanghai
+	// Shipping: {City:Beijing Street:Street 1}
+	// Billing: {City:Shenzhen Street:Street 2}
+}
+
+func ExampleBind_inline() {
+	type Embed struct {
+		Name string `json:"name"`
+		Age  int    `json:"age"`
+	}
+
+	var S struct {
+		Embed Embed  `json:",inline"`
+		Work  Embed  `json:",inline=work_"`
+		Desc  string `json:"desc"`
+	}
+
+	src := map[string]interface{}{"name": "Aaron", "age": 18, "work_name": "Engineer", "desc": "abc"}
+	if err := Bind(&S, src); err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Printf("%+v\n", S)
+
+	// Output:
+	// {Embed:{Name:Aaron Age:18} Work:{Name:Engineer Age:0} Desc:abc}
+}
+
+func ExampleBinder_SliceToStruct() {
+	type Row struct {
+		ID     int     `json:"id"`
+		Name   string  `json:"name"`
+		Ignore string  `json:"-"`
+		Score  float64 `json:"score"`
+		Remark string  `json:"remark"`
+	}
+
+	// Such as the records read by encoding/csv.
+	records := [][]string{
+		{"1", "Aaron", "95.5"},
+		{"2", "Bob", "80", "good", "extra"},
+	}
+
+	var rows []Row
+	binder := NewBinder(WithSliceToStruct())
+	if err := binder.Bind(&rows, records); err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	for _, row := range rows {
+		fmt.Printf("%+v\n", row)
+	}
+
+	var row Row
+	fmt.Println(binder.Bind(&row, []interface{}{"x"}))
+
+	// Output:
+	// {ID:1 Name:Aaron Ignore: Score:95.5 Remark:}
+	// {ID:2 Name:Bob Ignore: Score:80 Remark:good}
+	// path "id": strconv.ParseInt: parsing "x": invalid syntax
+}
+
+func ExampleBinder_TimeFromParts() {
+	var form struct {
+		Birthday time.Time `form:"birthday"`
+		Meeting  time.Time `form:"meeting"`
+		Created  time.Time `form:"created"`
+	}
+
+	src := map[string]interface{}{
+		"birthday": map[string]interface{}{"year": "1990", "month": "6", "day": "15"},
+		"meeting":  url.Values{"year": {"2023"}, "month": {"2"}, "day": {"1"}, "hour": {"14"}, "minute": {"30"}},
+		"created":  "2023-02-01T08:00:00Z", // Still parsed as the time string.
+	}
+
+	loc := time.FixedZone("UTC+8", 8*3600)
+	binder := NewBinder(WithTag("form"), WithTimeFromParts(), WithTimeLocation(loc))
+	if err := binder.Bind(&form, src); err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	fmt.Println(form.Birthday.Format(time.RFC3339))
+	fmt.Println(form.Meeting.Format(time.RFC3339))
+	fmt.Println(form.Created.Format(time.RFC3339))
+
+	err := binder.Bind(&form, map[string]interface{}{"meeting": map[string]string{"year": "x"}})
+	fmt.Println(err)
+
+	err = binder.Bind(&form, map[string]interface{}{"meeting": map[string]string{"name": "x"}})
+	fmt.Println(err)
+
+	binder.Strict = true
+	err = binder.Bind(&form, map[string]interface{}{"meeting": map[string]int{"year": 2023, "days": 1}})
+	fmt.Println(err)
+
+	// Output:
+	// 1990-06-15T00:00:00+08:00
+	// 2023-02-01T14:30:00+08:00
+	// 2023-02-01T08:00:00Z
+	// path "meeting.year": strconv.ParseInt: parsing "x": invalid syntax
+	// path "meeting": cannot bind map[string]string to time.Time: missing the time parts, such as 'year'
+	// path "meeting": unknown time part 'days'
+}
+
+func ExampleBinder_TimeFormatTag() {
+	var form struct {
+		Birthday time.Time   `form:"birthday" timeformat:"2006-01-02"`
+		Expires  *time.Time  `form:"expires" timeformat:"01/02/2006 15:04"`
+		Holidays []time.Time `form:"holidays" timeformat:"Jan 2, 2006"`
+		Created  time.Time   `form:"created"` // Use the global layouts
+	}
+
+	values := url.Values{
+		"birthday": {"1990-06-15"},
+		"expires":  {"12/31/2023 23:59"},
+		"holidays": {"Jan 1, 2023", "Dec 25, 2023"},
+		"created":  {"2023-02-01T08:00:00Z"},
+	}
+
+	binder := NewBinder(WithTag("form"))
+	if err := binder.Bind(&form, values); err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	fmt.Println(form.Birthday.Format(time.RFC3339))
+	fmt.Println(form.Expires.Format(time.RFC3339))
+	fmt.Println(form.Holidays[0].Format(time.DateOnly), form.Holidays[1].Format(time.DateOnly))
+	fmt.Println(form.Created.Format(time.RFC3339))
+
+	err := binder.Bind(&form, url.Values{"birthday": {"06/15/1990"}})
+	fmt.Println(err != nil)
+
+	// Output:
+	// 1990-06-15T00:00:00Z
+	// 2023-12-31T23:59:00Z
+	// 2023-01-01 2023-12-25
+	// 2023-02-01T08:00:00Z
+	// true
+}
+
+func ExampleBinder_TimeLocation() {
+	var form struct {
+		Local   time.Time `form:"local"`
+		Offset  time.Time `form:"offset"`
+		Date    time.Time `form:"date" timeformat:"2006-01-02"`
+		Unix    time.Time `form:"unix"`
+		Default time.Time `form:"default"`
+	}
+
+	values := url.Values{
+		"local":  {"2023-02-01 08:00:00"},
+		"offset": {"2023-02-01T08:00:00+01:00"}, // The offset takes precedence.
+		"date":   {"2023-02-01"},
+		"unix":   {"1675209600"},
+	}
+
+	loc := time.FixedZone("UTC+8", 8*3600)
+	binder := NewBinder(WithTag("form"), WithTimeLocation(loc))
+	if err := binder.Bind(&form, values); err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	fmt.Println(form.Local.Format(time.RFC3339))
+	fmt.Println(form.Offset.Format(time.RFC3339))
+	fmt.Println(form.Date.Format(time.RFC3339))
+	fmt.Println(form.Unix.Format(time.RFC3339))
+
+	// Without TimeLocation, use defaults.TimeLocation, that's, UTC.
+	_ = NewBinder(WithTag("form")).Bind(&form, url.Values{"default": {"2023-02-01 08:00:00"}})
+	fmt.Println(form.Default.Format(time.RFC3339))
+
+	// Output:
+	// 2023-02-01T08:00:00+08:00
+	// 2023-02-01T08:00:00+01:00
+	// 2023-02-01T00:00:00+08:00
+	// 2023-02-01T08:00:00+08:00
+	// 2023-02-01T08:00:00Z
+}
+
+func ExampleBinder_url() {
+	var config struct {
+		Homepage url.URL  `json:"homepage"`
+		Callback *url.URL `json:"callback"`
+	}
+
+	src := map[string]interface{}{
+		"homepage": "https://example.com/index.html",
+		"callback": "http://127.0.0.1:8080/callback?id=123",
+	}
+
+	if err := BindStructToMap(&config, "json", src); err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	fmt.Println(config.Homepage.Host, config.Homepage.Path)
+	fmt.Println(config.Callback.Host, config.Callback.Query().Get("id"))
+
+	err := BindStructToMap(&config, "json", map[string]interface{}{"callback": "http://[::1"})
+	fmt.Println(err)
+
+	// Output:
+	// example.com /index.html
+	// 127.0.0.1:8080 123
+	// path "callback": parse "http://[::1": missing ']' in host
+}
+
+func ExampleBinder_ip() {
+	var config struct {
+		IPv4    net.IP     `json:"ipv4"`
+		IPv6    net.IP     `json:"ipv6"`
+		Subnet4 *net.IPNet `json:"subnet4"`
+		Subnet6 net.IPNet  `json:"subnet6"`
+		Addr4   netip.Addr `json:"addr4"`
+		Addr6   netip.Addr `json:"addr6"`
+	}
+
+	src := map[string]interface{}{
+		"ipv4":    "10.0.0.1",
+		"ipv6":    "2001:db8::1",
+		"subnet4": "10.1.2.3/8",
+		"subnet6": "2001:db8::/32",
+		"addr4":   "192.168.1.1",
+		"addr6":   "2001:db8::1",
+	}
+
+	if err := BindStructToMap(&config, "json", src); err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	fmt.Println(config.IPv4, config.IPv6)
+	fmt.Println(config.Subnet4, config.Subnet6.String())
+	fmt.Println(config.Addr4, config.Addr6)
+
+	fmt.Println(BindStructToMap(&config, "json", map[string]interface{}{"ipv4": "10.0.0"}))
+	fmt.Println(BindStructToMap(&config, "json", map[string]interface{}{"subnet4": "10.0.0.0"}))
+	fmt.Println(BindStructToMap(&config, "json", map[string]interface{}{"addr6": "localhost"}))
+
+	// Output:
+	// 10.0.0.1 2001:db8::1
+	// 10.0.0.0/8 2001:db8::/32
+	// 192.168.1.1 2001:db8::1
+	// path "ipv4": invalid IP address: 10.0.0
+	// path "subnet4": invalid CIDR address: 10.0.0.0
+	// path "addr6": ParseAddr("localhost"): unable to parse IP
+}
+
+func ExampleBind_structSource() {
+	type Base struct {
+		ID string `json:"id"`
+	}
+
+	type AddressDTO struct {
+		City string `json:"city"`
+	}
+
+	src := struct {
+		Base
+		Name    string      `json:"name"`
+		Age     string      `json:"age"`
+		Address *AddressDTO `json:"address"`
+		Ignore  string      `json:"-"`
+		secret  string
+	}{
+		Base:    Base{ID: "123"},
+		Name:    "Aaron",
+		Age:     "18",
+		Address: &AddressDTO{City: "Beijing"},
+		Ignore:  "ignore",
+		secret:  "secret",
+	}
+
+	var dst struct {
+		ID      int    `json:"id"`
+		Name    string `json:"name"`
+		Age     int    `json:"age"`
+		Address struct {
+			City string `json:"city"`
+		} `json:"address"`
+		Ignore string `json:"ignore"`
+		Secret string `json:"secret"`
+	}
+
+	if err := BindWithTag(&dst, &src, "json"); err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	fmt.Printf("%+v\n", dst)
+
+	// Output:
+	// {ID:123 Name:Aaron Age:18 Address:{City:Beijing} Ignore: Secret:}
+}
+
+func ExampleBinder_TimeFormats() {
+	var v struct {
+		RFC3339  time.Time `json:"rfc3339"`
+		Nano     time.Time `json:"nano"`
+		DateOnly time.Time `json:"dateonly"`
+		Custom   time.Time `json:"custom"`
+	}
+
+	src := map[string]interface{}{
+		"rfc3339":  "2023-02-01T00:00:00Z",
+		"nano":     "2023-02-01T00:00:00.123456789Z",
+		"dateonly": "2023-02-01",
+	}
+
+	if err := BindWithTag(&v, src, "json"); err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	fmt.Println(v.RFC3339.Format(time.RFC3339Nano))
+	fmt.Println(v.Nano.Format(time.RFC3339Nano))
+	fmt.Println(v.DateOnly.Format(time.RFC3339Nano))
+
+	binder := NewBinder(WithTags("json"), WithTimeFormats("2006/01/02", time.DateOnly))
+	err := binder.Bind(&v, map[string]interface{}{"custom": "2023/02/01"})
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Println(v.Custom.Format(time.RFC3339Nano))
+
+	// Output:
+	// 2023-02-01T00:00:00Z
+	// 2023-02-01T00:00:00.123456789Z
+	// 2023-02-01T00:00:00Z
+	// 2023-02-01T00:00:00Z
+}
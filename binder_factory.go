@@ -0,0 +1,78 @@
+// Copyright 2023 xgfone
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package binder
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// DefaultDiscriminatorKey is the default key of the discriminator
+// used by DiscriminatorFactory.
+const DefaultDiscriminatorKey = "type"
+
+var stringType = reflect.TypeOf("")
+
+// InterfaceFactory is used to instantiate the concrete value
+// for the interface by the source, which is used by Binder.InterfaceFactories.
+//
+// If not instantiating any value, return the invalid reflect.Value.
+type InterfaceFactory func(src interface{}) (reflect.Value, error)
+
+// DiscriminatorFactory returns an interface factory, which looks up
+// the discriminator by the key from the source map, such as "type",
+// and instantiates the concrete type registered in types by reflect.New.
+//
+// If key is empty, use DefaultDiscriminatorKey instead.
+//
+// If the source is not a map with the string key, or the discriminator
+// is missing, return the invalid reflect.Value, and the interface is bound
+// as usual. If the discriminator is not registered, return an error.
+//
+// Example
+//
+//	binder.InterfaceFactories = map[reflect.Type]InterfaceFactory{
+//	    reflect.TypeOf((*Shape)(nil)).Elem(): DiscriminatorFactory("kind", map[string]reflect.Type{
+//	        "circle": reflect.TypeOf(Circle{}),
+//	        "square": reflect.TypeOf(Square{}),
+//	    }),
+//	}
+func DiscriminatorFactory(key string, types map[string]reflect.Type) InterfaceFactory {
+	if key == "" {
+		key = DefaultDiscriminatorKey
+	}
+
+	return func(src interface{}) (reflect.Value, error) {
+		srcValue := reflect.ValueOf(src)
+		if srcValue.Kind() != reflect.Map || srcValue.Type().Key() != stringType {
+			return reflect.Value{}, nil
+		}
+
+		value, ok := lookupMap(srcValue, src, key)
+		if !ok || value == nil {
+			return reflect.Value{}, nil
+		}
+
+		if ss, ok := value.([]string); ok && len(ss) > 0 {
+			value = ss[0]
+		}
+
+		name := fmt.Sprint(value)
+		if vtype, ok := types[name]; ok {
+			return reflect.New(vtype), nil
+		}
+		return reflect.Value{}, fmt.Errorf("unknown %s '%s'", key, name)
+	}
+}
@@ -0,0 +1,71 @@
+// Copyright 2023 xgfone
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package binder
+
+import (
+	"fmt"
+	"reflect"
+)
+
+type Shape interface{ Area() float64 }
+
+type Circle struct {
+	Radius float64 `json:"radius"`
+}
+
+type Square struct {
+	Side float64 `json:"side"`
+}
+
+func (c *Circle) Area() float64 { return 3 * c.Radius * c.Radius }
+func (s Square) Area() float64  { return s.Side * s.Side }
+
+func ExampleDiscriminatorFactory() {
+	binder := NewBinder(WithTag("json"), WithInterfaceFactories(map[reflect.Type]InterfaceFactory{
+		reflect.TypeOf((*Shape)(nil)).Elem(): DiscriminatorFactory("", map[string]reflect.Type{
+			"circle": reflect.TypeOf(Circle{}),
+			"square": reflect.TypeOf(Square{}),
+		}),
+	}))
+
+	var drawing struct {
+		Shapes []Shape `json:"shapes"`
+	}
+
+	err := binder.Bind(&drawing, map[string]interface{}{
+		"shapes": []interface{}{
+			map[string]interface{}{"type": "circle", "radius": 2},
+			map[string]interface{}{"type": "square", "side": "3"},
+		},
+	})
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	for _, shape := range drawing.Shapes {
+		fmt.Printf("%T: %v\n", shape, shape.Area())
+	}
+
+	err = binder.Bind(&drawing, map[string]interface{}{
+		"shapes": []interface{}{map[string]interface{}{"type": "triangle"}},
+	})
+	fmt.Println(err)
+
+	// Output:
+	// *binder.Circle: 12
+	// *binder.Square: 9
+	// path "shapes[0]": unknown type 'triangle'
+}
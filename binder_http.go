@@ -15,12 +15,18 @@
 package binder
 
 import (
+	"fmt"
+	"io"
 	"mime/multipart"
 	"net/http"
 	"net/textproto"
 	"net/url"
 	"reflect"
+	"sort"
+	"strconv"
+	"strings"
 
+	"github.com/xgfone/go-defaults/assists"
 	"github.com/xgfone/go-structs/field"
 )
 
@@ -48,9 +54,41 @@ func BindStructToURLValues(structptr interface{}, tag string, data url.Values) e
 // BindStructToHTTPHeader binds the struct to http.Header.
 //
 // For the key name, it will use textproto.CanonicalMIMEHeaderKey(s) to normalize it.
+//
+// For the field of time.Time, it will try to parse the header value
+// with the HTTP date formats, such as http.TimeFormat, first.
 func BindStructToHTTPHeader(structptr interface{}, tag string, data http.Header) error {
-	binder := NewBinder()
-	binder.GetFieldName = func(sf reflect.StructField) (name, arg string) {
+	return newHTTPHeaderBinder(tag).Bind(structptr, data)
+}
+
+func newHTTPHeaderBinder(tag string) Binder {
+	binder := NewBinderWithHook(httpTimeHook)
+	binder.GetFieldName = httpHeaderFieldName(tag)
+	return binder
+}
+
+// BindHTTPHeaderToMap binds the map, such as map[string]string
+// or map[string][]string, to http.Header.
+//
+// The header key is transformed by transform, such as strings.ToLower,
+// before binding, and the values of the keys transformed to the same one
+// are merged. If transform is nil, the key is kept, that's, canonicalized.
+func BindHTTPHeaderToMap(mapptr interface{}, data http.Header, transform func(key string) string) error {
+	if transform != nil {
+		header := make(http.Header, len(data))
+		for key, values := range data {
+			key = transform(key)
+			header[key] = append(header[key], values...)
+		}
+		data = header
+	}
+	return NewBinderWithHook(httpTimeHook).Bind(mapptr, data)
+}
+
+// httpHeaderFieldName returns a function to get the field name
+// by the tag, which is normalized by textproto.CanonicalMIMEHeaderKey.
+func httpHeaderFieldName(tag string) func(reflect.StructField) (name, arg string) {
+	return func(sf reflect.StructField) (name, arg string) {
 		switch name, arg = field.GetTag(sf, tag); name {
 		case "":
 			name = textproto.CanonicalMIMEHeaderKey(sf.Name)
@@ -61,12 +99,338 @@ func BindStructToHTTPHeader(structptr interface{}, tag string, data http.Header)
 		}
 		return
 	}
-	return binder.Bind(structptr, data)
 }
 
+// httpTimeHook parses the HTTP date string, such as the headers Date
+// and Last-Modified, to time.Time. If failing, let the binder go on.
+func httpTimeHook(dst reflect.Value, src interface{}) (interface{}, error) {
+	if dst.Type() != timeType {
+		return src, nil
+	}
+
+	var value string
+	switch v := src.(type) {
+	case string:
+		value = v
+	case []string:
+		if len(v) == 0 {
+			return src, nil
+		}
+		value = v[0]
+	default:
+		return src, nil
+	}
+
+	if t, err := http.ParseTime(value); err == nil {
+		return t, nil
+	}
+	return src, nil
+}
+
+// MaxMultipartFileSize is the maximum size of the multipart file
+// which is read fully into the []byte field.
+var MaxMultipartFileSize int64 = 10 << 20
+
 // BindStructToMultipartFileHeaders binds the struct to the multipart form file headers.
 //
+// Besides *multipart.FileHeader, the field may be
+//   - io.Reader or io.ReadCloser: the opened file, which should be closed by the caller.
+//   - []byte: the fully-read file content, the size of which must not exceed MaxMultipartFileSize.
+//
+// Before binding, the files are checked by the field arguments "accept"
+// and "maxsize", see checkMultipartFiles.
+//
 // For the key name, it is case-sensitive.
 func BindStructToMultipartFileHeaders(structptr interface{}, tag string, fhs map[string][]*multipart.FileHeader) error {
-	return BindWithTag(structptr, fhs, tag)
+	if err := checkMultipartFileFields(structptr, tag, fhs); err != nil {
+		return err
+	}
+	return newMultipartFileBinder(tag).Bind(structptr, fhs)
+}
+
+// checkMultipartFileFields checks the multipart files of the struct fields
+// by their field arguments before binding them, so that the rejected file
+// is never opened or read.
+func checkMultipartFileFields(structptr interface{}, tag string, fhs map[string][]*multipart.FileHeader) (err error) {
+	v, err := getStructValue(structptr)
+	if err != nil {
+		return nil // Let the binder report the error.
+	}
+
+	e := structEncoder{getFieldName: assists.StructFieldNameFuncWithTags(tag)}
+	e.rangeFields(v, func(name, arg string, value reflect.Value) {
+		if files, ok := fhs[name]; ok && err == nil {
+			if _err := checkMultipartFiles(files, arg); _err != nil {
+				err = &BindError{Path: name, Kind: value.Kind(), Source: files, Err: _err}
+			}
+		}
+	})
+	return
+}
+
+func newMultipartFileBinder(tag string) Binder {
+	binder := NewBinderWithHook(multipartFileHook)
+	binder.TagName = tag
+	return binder
+}
+
+var (
+	readerType     = reflect.TypeOf((*io.Reader)(nil)).Elem()
+	readCloserType = reflect.TypeOf((*io.ReadCloser)(nil)).Elem()
+	bytesType      = reflect.TypeOf([]byte(nil))
+)
+
+// multipartFileHook opens the multipart file for io.Reader and io.ReadCloser,
+// or reads the whole file content for []byte.
+func multipartFileHook(dst reflect.Value, src interface{}) (interface{}, error) {
+	var fh *multipart.FileHeader
+	switch v := src.(type) {
+	case *multipart.FileHeader:
+		fh = v
+	case []*multipart.FileHeader:
+		if len(v) == 0 {
+			return src, nil
+		}
+		fh = v[0]
+	default:
+		return src, nil
+	}
+
+	switch dst.Type() {
+	case readerType, readCloserType:
+		file, err := fh.Open()
+		if err != nil {
+			return nil, fmt.Errorf("fail to open the multipart file '%s': %w", fh.Filename, err)
+		}
+		return file, nil
+
+	case bytesType:
+		return readMultipartFile(fh, MaxMultipartFileSize)
+
+	default:
+		return src, nil
+	}
+}
+
+func readMultipartFile(fh *multipart.FileHeader, maxsize int64) ([]byte, error) {
+	if fh.Size > maxsize {
+		return nil, fmt.Errorf("the size %d of the multipart file '%s' exceeds the limit %d",
+			fh.Size, fh.Filename, maxsize)
+	}
+
+	file, err := fh.Open()
+	if err != nil {
+		return nil, fmt.Errorf("fail to open the multipart file '%s': %w", fh.Filename, err)
+	}
+	defer file.Close()
+
+	data, err := io.ReadAll(io.LimitReader(file, maxsize+1))
+	if err != nil {
+		return nil, fmt.Errorf("fail to read the multipart file '%s': %w", fh.Filename, err)
+	} else if int64(len(data)) > maxsize {
+		return nil, fmt.Errorf("the size of the multipart file '%s' exceeds the limit %d",
+			fh.Filename, maxsize)
+	}
+	return data, nil
+}
+
+// checkMultipartFiles checks the multipart files in src by the field
+// arguments "accept" and "maxsize", such as
+//
+//	form:"avatar,accept=image/png|image/jpeg,maxsize=1MiB"
+//	form:"files,accept=image/*|application/pdf,maxsize=512KB"
+//
+// The unit of maxsize is the same as ByteSize, such as "1MiB" is 1048576
+// bytes and "512KB" is 512000 bytes.
+//
+// If src is not *multipart.FileHeader or []*multipart.FileHeader, do nothing.
+func checkMultipartFiles(src interface{}, arg string) (err error) {
+	accept, hasAccept := lookupFieldArg(arg, "accept")
+	maxsize, hasMaxsize := lookupFieldArg(arg, "maxsize")
+	if !hasAccept && !hasMaxsize {
+		return
+	}
+
+	var fhs []*multipart.FileHeader
+	switch v := src.(type) {
+	case *multipart.FileHeader:
+		fhs = []*multipart.FileHeader{v}
+	case []*multipart.FileHeader:
+		fhs = v
+	default:
+		return
+	}
+
+	var max int64
+	if hasMaxsize {
+		if max, err = parseByteSize(maxsize); err != nil {
+			return fmt.Errorf("invalid maxsize '%s'", maxsize)
+		}
+	}
+
+	for _, fh := range fhs {
+		if hasAccept {
+			if ct := fh.Header.Get("Content-Type"); !isAcceptedContentType(accept, ct) {
+				return fmt.Errorf("the content type '%s' of the multipart file '%s' is not accepted",
+					ct, fh.Filename)
+			}
+		}
+
+		if hasMaxsize && fh.Size > max {
+			return fmt.Errorf("the size %d of the multipart file '%s' exceeds the limit %d",
+				fh.Size, fh.Filename, max)
+		}
+	}
+
+	return
+}
+
+// isAcceptedContentType reports whether the content type ct is one of
+// the accepted types separated by "|", which supports the wildcard
+// subtype, such as "image/*".
+func isAcceptedContentType(accepts, ct string) bool {
+	ct, _, _ = strings.Cut(ct, ";")
+	ct = strings.ToLower(strings.TrimSpace(ct))
+	if ct == "" {
+		return false
+	}
+
+	for _, accept := range strings.Split(accepts, "|") {
+		accept = strings.ToLower(strings.TrimSpace(accept))
+		if accept == ct || accept == "*/*" {
+			return true
+		} else if prefix, ok := strings.CutSuffix(accept, "/*"); ok && strings.HasPrefix(ct, prefix+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+// ExpandBracketKeys expands the bracketed keys of url.Values into the nested
+// map[string]interface{}, such as
+//
+//	user[name]=bob&user[age]=30   => {"user": {"name": ["bob"], "age": ["30"]}}
+//	a[b][c]=1                     => {"a": {"b": {"c": ["1"]}}}
+//	tags[0]=x&tags[1]=y           => {"tags": [["x"], ["y"]]}
+//	tags[]=x&tags[]=y             => {"tags": ["x", "y"]}
+//
+// The leaf values are still []string. If all the keys of a nested map are
+// the non-negative integers, it is converted to []interface{} ordered by
+// the index, and the missing indexes are filled with nil. But if any index
+// exceeds MaxBracketIndex, the nested map is kept as it is, which avoids
+// allocating the huge slice by the key like "tags[50000000]".
+//
+// If a key is used as both the leaf and the nested map, return an error.
+func ExpandBracketKeys(values url.Values) (map[string]interface{}, error) {
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys) // Make the conflict error deterministic.
+
+	maps := make(map[string]interface{}, len(values))
+	for _, key := range keys {
+		name, segments := splitBracketKey(key)
+		if err := setBracketValue(maps, key, name, segments, values[key]); err != nil {
+			return nil, err
+		}
+	}
+
+	for key, value := range maps {
+		maps[key] = convertIndexMap(value)
+	}
+	return maps, nil
+}
+
+// MaxBracketIndex is the maximum index of the bracketed key, such as
+// "tags[1000]", which is expanded into the slice by ExpandBracketKeys.
+const MaxBracketIndex = 1000
+
+// splitBracketKey splits the key like "a[b][c]" to "a" and ["b", "c"].
+func splitBracketKey(key string) (name string, segments []string) {
+	index := strings.IndexByte(key, '[')
+	if index <= 0 || !strings.HasSuffix(key, "]") {
+		return key, nil
+	}
+
+	name, rest := key[:index], key[index:]
+	for len(rest) > 0 && rest[0] == '[' {
+		end := strings.IndexByte(rest, ']')
+		if end < 0 {
+			break
+		}
+		segments = append(segments, rest[1:end])
+		rest = rest[end+1:]
+	}
+
+	if len(rest) > 0 { // Invalid format, such as "a[b]c]", use it as is.
+		return key, nil
+	}
+
+	// "a[]" is the same as "a".
+	if last := len(segments) - 1; segments[last] == "" {
+		segments = segments[:last]
+	}
+	return
+}
+
+func setBracketValue(maps map[string]interface{}, key, name string, segments []string, values []string) error {
+	if len(segments) == 0 {
+		switch v := maps[name].(type) {
+		case nil:
+			maps[name] = values
+		case []string:
+			// Copy the values to avoid modifying the input url.Values.
+			maps[name] = append(append(make([]string, 0, len(v)+len(values)), v...), values...)
+		default:
+			return fmt.Errorf("conflict key '%s': it is used as both a value and a map", key)
+		}
+		return nil
+	}
+
+	var submaps map[string]interface{}
+	switch v := maps[name].(type) {
+	case nil:
+		submaps = make(map[string]interface{}, 4)
+		maps[name] = submaps
+	case map[string]interface{}:
+		submaps = v
+	default:
+		return fmt.Errorf("conflict key '%s': it is used as both a value and a map", key)
+	}
+
+	return setBracketValue(submaps, key, segments[0], segments[1:], values)
+}
+
+func convertIndexMap(value interface{}) interface{} {
+	maps, ok := value.(map[string]interface{})
+	if !ok {
+		return value
+	}
+
+	for key, value := range maps {
+		maps[key] = convertIndexMap(value)
+	}
+
+	if len(maps) == 0 {
+		return maps
+	}
+
+	maxIndex := -1
+	for key := range maps {
+		index, err := strconv.Atoi(key)
+		if err != nil || index < 0 || index > MaxBracketIndex || strconv.Itoa(index) != key {
+			return maps
+		}
+		if index > maxIndex {
+			maxIndex = index
+		}
+	}
+
+	list := make([]interface{}, maxIndex+1)
+	for key, value := range maps {
+		index, _ := strconv.Atoi(key)
+		list[index] = value
+	}
+	return list
 }
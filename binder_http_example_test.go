@@ -15,10 +15,15 @@
 package binder
 
 import (
+	"bytes"
 	"fmt"
+	"io"
 	"mime/multipart"
 	"net/http"
+	"net/textproto"
 	"net/url"
+	"strings"
+	"time"
 )
 
 func ExampleBindStructToStringMap() {
@@ -156,3 +161,316 @@ func ExampleBindStructToMultipartFileHeaders() {
 	// file1
 	// file2
 }
+
+func ExampleBindStructToHTTPHeader_httpDate() {
+	src := http.Header{
+		"Date":          []string{"Mon, 02 Jan 2006 15:04:05 GMT"},
+		"Last-Modified": []string{"Monday, 02-Jan-06 15:04:05 GMT"}, // RFC 850
+		"Expires":       []string{"2006-01-02T15:04:05Z"},           // RFC 3339
+	}
+
+	var dst struct {
+		Date         time.Time  `header:"Date"`
+		LastModified *time.Time `header:"Last-Modified"`
+		Expires      time.Time  `header:"Expires"`
+	}
+
+	err := BindStructToHTTPHeader(&dst, "header", src)
+	if err != nil {
+		fmt.Println(err)
+	} else {
+		fmt.Printf("Date=%s\n", dst.Date.Format(time.RFC3339))
+		fmt.Printf("LastModified=%s\n", dst.LastModified.Format(time.RFC3339))
+		fmt.Printf("Expires=%s\n", dst.Expires.Format(time.RFC3339))
+	}
+
+	// Output:
+	// Date=2006-01-02T15:04:05Z
+	// LastModified=2006-01-02T15:04:05Z
+	// Expires=2006-01-02T15:04:05Z
+}
+
+func ExampleBinder_SliceToSingleIndex() {
+	src := http.Header{"X-Forwarded-For": []string{"1.1.1.1", "2.2.2.2", "3.3.3.3"}}
+
+	var dst struct {
+		ForwardedFor string `json:"X-Forwarded-For"`
+	}
+
+	for _, index := range []int{0, 1, -1, 10, -10} {
+		binder := NewBinder()
+		binder.SliceToSingleIndex = index
+		if err := binder.Bind(&dst, src); err != nil {
+			fmt.Println(err)
+		} else {
+			fmt.Printf("%d: %s\n", index, dst.ForwardedFor)
+		}
+	}
+
+	// Output:
+	// 0: 1.1.1.1
+	// 1: 2.2.2.2
+	// -1: 3.3.3.3
+	// 10: 3.3.3.3
+	// -10: 1.1.1.1
+}
+
+func ExampleBinder_BracketKeys() {
+	query, _ := url.ParseQuery("user[name]=bob&user[age]=30&user[addr][city]=NY" +
+		"&tags[1]=y&tags[0]=x&ids[]=1&ids[]=2&items[0][name]=a&items[1][name]=b")
+
+	var dst struct {
+		User struct {
+			Name string `query:"name"`
+			Age  int    `query:"age"`
+			Addr struct {
+				City string `query:"city"`
+			} `query:"addr"`
+		} `query:"user"`
+		Tags  []string `query:"tags"`
+		IDs   []int    `query:"ids"`
+		Items []struct {
+			Name string `query:"name"`
+		} `query:"items"`
+	}
+
+	binder := Binder{TagName: "query", BracketKeys: true, ConvertSliceToSingle: true}
+	if err := binder.Bind(&dst, query); err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	fmt.Printf("User: %+v\n", dst.User)
+	fmt.Printf("Tags: %v\n", dst.Tags)
+	fmt.Printf("IDs: %v\n", dst.IDs)
+	fmt.Printf("Items: %+v\n", dst.Items)
+
+	_, err := ExpandBracketKeys(url.Values{"a": {"1"}, "a[b]": {"2"}})
+	fmt.Println(err)
+
+	// The too large index is kept as the map, and the malformed key as is.
+	maps, _ := ExpandBracketKeys(url.Values{"tags[50000000]": {"x"}, "a[b]]": {"y"}})
+	fmt.Println(maps)
+
+	// The input values are not modified when merging "ids" and "ids[]".
+	ids := []string{"1", "-"}
+	values := url.Values{"ids": ids[:1], "ids[]": {"2"}}
+	maps, _ = ExpandBracketKeys(values)
+	fmt.Println(maps, values, ids)
+
+	// Output:
+	// User: {Name:bob Age:30 Addr:{City:NY}}
+	// Tags: [x y]
+	// IDs: [1 2]
+	// Items: [{Name:a} {Name:b}]
+	// conflict key 'a[b]': it is used as both a value and a map
+	// map[a[b]]:[y] tags:map[50000000:[x]]]
+	// map[ids:[1 2]] map[ids:[1] ids[]:[2]] [1 -]
+}
+
+func ExampleBindStructToMultipartFileHeaders_reader() {
+	body := new(bytes.Buffer)
+	writer := multipart.NewWriter(body)
+	for name, content := range map[string]string{"avatar": "avatar content", "doc": "doc content"} {
+		w, _ := writer.CreateFormFile(name, name+".txt")
+		_, _ = io.WriteString(w, content)
+	}
+	_ = writer.Close()
+
+	form, err := multipart.NewReader(body, writer.Boundary()).ReadForm(1 << 20)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	defer func() { _ = form.RemoveAll() }()
+
+	var dst struct {
+		Avatar io.ReadCloser `form:"avatar"`
+		Doc    []byte        `form:"doc"`
+	}
+
+	err = BindStructToMultipartFileHeaders(&dst, "form", form.File)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	defer dst.Avatar.Close()
+
+	avatar, _ := io.ReadAll(dst.Avatar)
+	fmt.Printf("Avatar: %s\n", avatar)
+	fmt.Printf("Doc: %s\n", dst.Doc)
+
+	maxsize := MaxMultipartFileSize
+	MaxMultipartFileSize = 4
+	defer func() { MaxMultipartFileSize = maxsize }()
+
+	var doc struct {
+		Doc []byte `form:"doc"`
+	}
+	err = BindStructToMultipartFileHeaders(&doc, "form", form.File)
+	fmt.Println(err)
+
+	// Output:
+	// Avatar: avatar content
+	// Doc: doc content
+	// path "doc": the size 11 of the multipart file 'doc.txt' exceeds the limit 4
+}
+
+func ExampleBindStructToMultipartFileHeaders_accept() {
+	newFile := func(filename, ct string, size int64) *multipart.FileHeader {
+		header := make(textproto.MIMEHeader)
+		header.Set("Content-Type", ct)
+		return &multipart.FileHeader{Filename: filename, Header: header, Size: size}
+	}
+
+	type Upload struct {
+		Avatar *multipart.FileHeader   `form:"avatar,accept=image/png|image/jpeg,maxsize=1MiB"`
+		Docs   []*multipart.FileHeader `form:"docs,accept=text/*|application/pdf"`
+	}
+
+	for _, fhs := range []map[string][]*multipart.FileHeader{
+		{
+			"avatar": {newFile("a.png", "image/png", 1024)},
+			"docs":   {newFile("a.txt", "text/plain; charset=utf-8", 10), newFile("b.pdf", "application/pdf", 10)},
+		},
+		{"avatar": {newFile("a.gif", "image/gif", 1024)}},
+		{"avatar": {newFile("b.jpg", "image/jpeg", 2<<20)}},
+		{"docs": {newFile("a.txt", "text/plain", 10), newFile("c.exe", "application/octet-stream", 10)}},
+	} {
+		var upload Upload
+		if err := BindStructToMultipartFileHeaders(&upload, "form", fhs); err != nil {
+			fmt.Println(err)
+		} else {
+			fmt.Printf("Avatar=%s, Docs=%d\n", upload.Avatar.Filename, len(upload.Docs))
+		}
+	}
+
+	// Output:
+	// Avatar=a.png, Docs=2
+	// path "avatar": the content type 'image/gif' of the multipart file 'a.gif' is not accepted
+	// path "avatar": the size 2097152 of the multipart file 'b.jpg' exceeds the limit 1048576
+	// path "docs": the content type 'application/octet-stream' of the multipart file 'c.exe' is not accepted
+}
+
+func ExampleBinder_ErrorOnMultiToSingle() {
+	var query struct {
+		ID   int      `query:"id"`
+		Tags []string `query:"tag"`
+	}
+
+	binder := NewBinder(WithTag("query"), WithErrorOnMultiToSingle())
+
+	err := binder.Bind(&query, url.Values{"id": {"1"}, "tag": {"a", "b"}})
+	fmt.Printf("ID=%d, Tags=%v, err=%v\n", query.ID, query.Tags, err)
+
+	err = binder.Bind(&query, url.Values{"id": {"1", "2"}})
+	fmt.Println(err)
+
+	// Output:
+	// ID=1, Tags=[a b], err=<nil>
+	// path "id": cannot bind 2 values to the single int
+}
+
+func ExampleBindStructToURLValues_json() {
+	type Filter struct {
+		Status string   `query:"status"`
+		Tags   []string `query:"tags"`
+	}
+
+	var query struct {
+		Filter Filter            `query:"filter,json"`
+		Sort   map[string]string `query:"sort,json"`
+		IDs    []int             `query:"ids,json"`
+		Names  []string          `query:"names,json"` // Not JSON-looking
+	}
+
+	values, _ := url.ParseQuery(`filter={"status":"open","tags":["a","b"]}&sort={"created":"desc"}&ids=[1,2,3]&names=x&names=y`)
+	if err := BindStructToURLValues(&query, "query", values); err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	fmt.Printf("%+v\n", query.Filter)
+	fmt.Println(query.Sort, query.IDs, query.Names)
+
+	err := BindStructToURLValues(&query, "query", url.Values{"filter": {`{"status":`}})
+	fmt.Println(err)
+
+	// Output:
+	// {Status:open Tags:[a b]}
+	// map[created:desc] [1 2 3] [x y]
+	// path "filter": invalid JSON source: unexpected end of JSON input
+}
+
+func ExampleBindHTTPHeaderToMap() {
+	header := http.Header{}
+	header.Set("Content-Type", "application/json")
+	header.Add("X-Request-Id", "abc")
+	header.Add("X-Tags", "a")
+	header.Add("X-Tags", "b")
+
+	var canonical map[string]string
+	_ = BindHTTPHeaderToMap(&canonical, header, nil)
+	fmt.Println(canonical)
+
+	var lower map[string]string
+	_ = BindHTTPHeaderToMap(&lower, header, strings.ToLower)
+	fmt.Println(lower)
+
+	var multi map[string][]string
+	_ = BindHTTPHeaderToMap(&multi, header, strings.ToLower)
+	fmt.Println(multi["x-tags"])
+
+	// Output:
+	// map[Content-Type:application/json X-Request-Id:abc X-Tags:a]
+	// map[content-type:application/json x-request-id:abc x-tags:a]
+	// [a b]
+}
+
+func ExampleBindStructToURLValues_explode() {
+	var query struct {
+		Tags   []string `query:"tags,explode=false"`
+		IDs    []int    `query:"ids,explode=false"`
+		Codes  []string `query:"codes,explode=false"`
+		Empty  []string `query:"empty,explode=false"`
+		Normal []string `query:"normal"`
+	}
+
+	values := url.Values{
+		"tags":   []string{"a,b,c"},
+		"ids":    []string{"1,2", "3"},
+		"codes":  []string{"x|y"},
+		"empty":  []string{""},
+		"normal": []string{"a,b"},
+	}
+
+	err := BindStructToURLValues(&query, "query", values)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	fmt.Printf("Tags: %q\n", query.Tags)
+	fmt.Printf("IDs: %v\n", query.IDs)
+	fmt.Printf("Codes: %q\n", query.Codes)
+	fmt.Printf("Empty: %d, %v\n", len(query.Empty), query.Empty != nil)
+	fmt.Printf("Normal: %q\n", query.Normal)
+
+	var codes struct {
+		Codes []string `query:"codes,explode=false"`
+	}
+	binder := NewBinder(WithTags("query"), WithExplodeSeparator("|"))
+	if err := binder.Bind(&codes, url.Values{"codes": values["codes"]}); err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Printf("Codes: %q\n", codes.Codes)
+
+	// Output:
+	// Tags: ["a" "b" "c"]
+	// IDs: [1 2 3]
+	// Codes: ["x|y"]
+	// Empty: 0, true
+	// Normal: ["a,b"]
+	// Codes: ["x" "y"]
+}
@@ -0,0 +1,70 @@
+// Copyright 2023 xgfone
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package binder
+
+import (
+	"reflect"
+	"strings"
+	"unicode"
+
+	"github.com/xgfone/go-structs/field"
+)
+
+// SnakeCaseFieldName is a function to get the name and arg of the struct
+// field, which can be used as Binder.GetFieldName.
+//
+// If the field has the tag "json" with the name, use it. Or, convert
+// the field name to the snake case, such as "UserID" to "user_id".
+func SnakeCaseFieldName(sf reflect.StructField) (name, arg string) {
+	return getCaseFieldName(sf, '_')
+}
+
+// KebabCaseFieldName is a function to get the name and arg of the struct
+// field, which can be used as Binder.GetFieldName.
+//
+// If the field has the tag "json" with the name, use it. Or, convert
+// the field name to the kebab case, such as "UserID" to "user-id".
+func KebabCaseFieldName(sf reflect.StructField) (name, arg string) {
+	return getCaseFieldName(sf, '-')
+}
+
+func getCaseFieldName(sf reflect.StructField, sep rune) (name, arg string) {
+	switch name, arg = field.GetTag(sf, "json"); name {
+	case "-":
+		name = ""
+	case "":
+		name = splitCamelCase(sf.Name, sep)
+	}
+	return
+}
+
+// splitCamelCase converts the camel case string s to the lower case words
+// separated by sep, such as "HTTPServerID" to "http_server_id".
+func splitCamelCase(s string, sep rune) string {
+	runes := []rune(s)
+	var b strings.Builder
+	b.Grow(len(s) + 4)
+	for i, r := range runes {
+		if unicode.IsUpper(r) && i > 0 {
+			prev := runes[i-1]
+			if unicode.IsLower(prev) || unicode.IsDigit(prev) ||
+				(unicode.IsUpper(prev) && i+1 < len(runes) && unicode.IsLower(runes[i+1])) {
+				b.WriteRune(sep)
+			}
+		}
+		b.WriteRune(unicode.ToLower(r))
+	}
+	return b.String()
+}
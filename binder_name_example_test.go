@@ -0,0 +1,67 @@
+// Copyright 2023 xgfone
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package binder
+
+import "fmt"
+
+func ExampleSnakeCaseFieldName() {
+	var S struct {
+		UserID       int
+		HTTPServer   string
+		FirstName    string
+		Address2     string
+		ExplicitName string `json:"explicit"`
+		Ignore       string `json:"-"`
+	}
+
+	maps := map[string]interface{}{
+		"user_id":     1,
+		"http_server": "server",
+		"first_name":  "Aaron",
+		"address2":    "somewhere",
+		"explicit":    "explicit",
+		"ignore":      "ignore",
+	}
+
+	err := Binder{GetFieldName: SnakeCaseFieldName}.Bind(&S, maps)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	fmt.Printf("%+v\n", S)
+
+	// Output:
+	// {UserID:1 HTTPServer:server FirstName:Aaron Address2:somewhere ExplicitName:explicit Ignore:}
+}
+
+func ExampleKebabCaseFieldName() {
+	var S struct {
+		UserID    int
+		FirstName string
+	}
+
+	maps := map[string]interface{}{"user-id": 1, "first-name": "Aaron"}
+	err := Binder{GetFieldName: KebabCaseFieldName}.Bind(&S, maps)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	fmt.Printf("%+v\n", S)
+
+	// Output:
+	// {UserID:1 FirstName:Aaron}
+}
@@ -0,0 +1,295 @@
+// Copyright 2023 xgfone
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package binder
+
+import (
+	"context"
+	"reflect"
+	"time"
+)
+
+// Option is used to customize the binder.
+type Option func(*Binder)
+
+// With returns a copy of the binder customized by the options,
+// which does not modify the original binder.
+func (b Binder) With(opts ...Option) Binder {
+	for _, opt := range opts {
+		opt(&b)
+	}
+	return b
+}
+
+// WithHook returns a copy of the binder with the hook.
+func (b Binder) WithHook(hook Hook) Binder {
+	b.Hook = hook
+	return b
+}
+
+// WithTag returns a copy of the binder with the tag name,
+// which is equal to b.With(WithTag(tag)).
+func (b Binder) WithTag(tag string) Binder {
+	b.TagName = tag
+	return b
+}
+
+// WithStrict returns a copy of the binder with Strict set,
+// which is equal to b.With(WithStrict()) if strict is true.
+func (b Binder) WithStrict(strict bool) Binder {
+	b.Strict = strict
+	return b
+}
+
+// WithConvertSliceToSingle returns an option to set ConvertSliceToSingle.
+func WithConvertSliceToSingle(convert bool) Option {
+	return func(b *Binder) { b.ConvertSliceToSingle = convert }
+}
+
+// WithSliceToSingleIndex returns an option to set SliceToSingleIndex.
+func WithSliceToSingleIndex(index int) Option {
+	return func(b *Binder) { b.SliceToSingleIndex = index }
+}
+
+// WithErrorOnMultiToSingle returns an option to enable ErrorOnMultiToSingle.
+func WithErrorOnMultiToSingle() Option {
+	return func(b *Binder) { b.ErrorOnMultiToSingle = true }
+}
+
+// WithConvertSingleToSlice returns an option to set ConvertSingleToSlice.
+func WithConvertSingleToSlice(convert bool) Option {
+	return func(b *Binder) { b.ConvertSingleToSlice = convert }
+}
+
+// WithMapToSlice returns an option to set MapToSlice and SortMapToSlice.
+func WithMapToSlice(values, sorted bool) Option {
+	return func(b *Binder) { b.MapToSlice, b.SortMapToSlice = values, sorted }
+}
+
+// WithMapKeysToSlice returns an option to set MapKeysToSlice and SortMapToSlice.
+func WithMapKeysToSlice(keys, sorted bool) Option {
+	return func(b *Binder) { b.MapKeysToSlice, b.SortMapToSlice = keys, sorted }
+}
+
+// WithTag returns an option to set TagName like Binder.WithTag.
+func WithTag(tag string) Option {
+	return func(b *Binder) { b.TagName = tag }
+}
+
+// WithTags returns an option to set Tags.
+func WithTags(tags ...string) Option {
+	return func(b *Binder) { b.Tags = tags }
+}
+
+// WithGetFieldName returns an option to set GetFieldName.
+func WithGetFieldName(get func(reflect.StructField) (name, arg string)) Option {
+	return func(b *Binder) { b.GetFieldName = get }
+}
+
+// WithHook returns an option to set Hook.
+func WithHook(hook Hook) Option {
+	return func(b *Binder) { b.Hook = hook }
+}
+
+// WithPathHook returns an option to set PathHook.
+func WithPathHook(hook PathHook) Option {
+	return func(b *Binder) { b.PathHook = hook }
+}
+
+// WithContextHook returns an option to set ContextHook.
+func WithContextHook(hook func(ctx context.Context, path string, dst reflect.Value, src interface{}) (interface{}, error)) Option {
+	return func(b *Binder) { b.ContextHook = hook }
+}
+
+// WithMergeMaps returns an option to enable MergeMaps.
+func WithMergeMaps() Option {
+	return func(b *Binder) { b.MergeMaps = true }
+}
+
+// WithAppendSlices returns an option to enable AppendSlices.
+func WithAppendSlices() Option {
+	return func(b *Binder) { b.AppendSlices = true }
+}
+
+// WithBracketKeys returns an option to enable BracketKeys.
+func WithBracketKeys() Option {
+	return func(b *Binder) { b.BracketKeys = true }
+}
+
+// WithFlattenSeparator returns an option to set FlattenSeparator.
+func WithFlattenSeparator(sep string) Option {
+	return func(b *Binder) { b.FlattenSeparator = sep }
+}
+
+// WithExplodeSeparator returns an option to set ExplodeSeparator.
+func WithExplodeSeparator(sep string) Option {
+	return func(b *Binder) { b.ExplodeSeparator = sep }
+}
+
+// WithConverters returns an option to set Converters.
+func WithConverters(converters map[ConvertKey]func(src interface{}) (interface{}, error)) Option {
+	return func(b *Binder) { b.Converters = converters }
+}
+
+// WithInterfaceFactories returns an option to set InterfaceFactories.
+func WithInterfaceFactories(factories map[reflect.Type]InterfaceFactory) Option {
+	return func(b *Binder) { b.InterfaceFactories = factories }
+}
+
+// WithAfterField returns an option to set AfterField.
+func WithAfterField(after func(dst reflect.Value, field reflect.StructField, src interface{}) error) Option {
+	return func(b *Binder) { b.AfterField = after }
+}
+
+// WithFieldValidator returns an option to set FieldValidator.
+func WithFieldValidator(validate func(field reflect.StructField, value reflect.Value) error) Option {
+	return func(b *Binder) { b.FieldValidator = validate }
+}
+
+// WithOnField returns an option to set OnField.
+func WithOnField(f func(path string, field reflect.StructField, matched bool)) Option {
+	return func(b *Binder) { b.OnField = f }
+}
+
+// WithFieldResolver returns an option to set FieldResolver.
+func WithFieldResolver(resolve func(sf reflect.StructField, src map[string]interface{}) (interface{}, bool)) Option {
+	return func(b *Binder) { b.FieldResolver = resolve }
+}
+
+// WithNilStrings returns an option to set NilStrings.
+func WithNilStrings(nils ...string) Option {
+	return func(b *Binder) { b.NilStrings = nils }
+}
+
+// WithNullStrings returns an option to set NullStrings,
+// which are matched case-insensitively if fold is true.
+func WithNullStrings(fold bool, ss ...string) Option {
+	return func(b *Binder) { b.NullStrings, b.NullStringsFold = ss, fold }
+}
+
+// WithNullAsNil returns an option to enable NullAsNil.
+func WithNullAsNil() Option {
+	return func(b *Binder) { b.NullAsNil = true }
+}
+
+// WithZeroFields returns an option to enable ZeroFields.
+func WithZeroFields() Option {
+	return func(b *Binder) { b.ZeroFields = true }
+}
+
+// WithCaseInsensitive returns an option to enable CaseInsensitive.
+func WithCaseInsensitive() Option {
+	return func(b *Binder) { b.CaseInsensitive = true }
+}
+
+// WithArrayStrictLength returns an option to enable ArrayStrictLength.
+func WithArrayStrictLength() Option {
+	return func(b *Binder) { b.ArrayStrictLength = true }
+}
+
+// WithDurationUnit returns an option to set DurationUnit.
+func WithDurationUnit(unit time.Duration) Option {
+	return func(b *Binder) { b.DurationUnit = unit }
+}
+
+// WithBoolValues returns an option to set BoolTrueValues and BoolFalseValues.
+func WithBoolValues(trues, falses []string) Option {
+	return func(b *Binder) { b.BoolTrueValues, b.BoolFalseValues = trues, falses }
+}
+
+// WithEmptyStringAsZero returns an option to enable EmptyStringAsZero.
+func WithEmptyStringAsZero() Option {
+	return func(b *Binder) { b.EmptyStringAsZero = true }
+}
+
+// WithTrimSpace returns an option to enable TrimSpace.
+func WithTrimSpace() Option {
+	return func(b *Binder) { b.TrimSpace = true }
+}
+
+// WithSliceToStruct returns an option to enable SliceToStruct.
+func WithSliceToStruct() Option {
+	return func(b *Binder) { b.SliceToStruct = true }
+}
+
+// WithWeaklyTyped returns an option to enable WeaklyTyped.
+func WithWeaklyTyped() Option {
+	return func(b *Binder) { b.WeaklyTyped = true }
+}
+
+// WithCopyAssignable returns an option to enable CopyAssignable.
+func WithCopyAssignable() Option {
+	return func(b *Binder) { b.CopyAssignable = true }
+}
+
+// WithTimeFromParts returns an option to enable TimeFromParts.
+func WithTimeFromParts() Option {
+	return func(b *Binder) { b.TimeFromParts = true }
+}
+
+// WithTimeLocation returns an option to set TimeLocation.
+func WithTimeLocation(loc *time.Location) Option {
+	return func(b *Binder) { b.TimeLocation = loc }
+}
+
+// WithTimeFormats returns an option to set TimeFormats.
+func WithTimeFormats(layouts ...string) Option {
+	return func(b *Binder) { b.TimeFormats = layouts }
+}
+
+// WithTimeFormatTag returns an option to set TimeFormatTag.
+func WithTimeFormatTag(tag string) Option {
+	return func(b *Binder) { b.TimeFormatTag = tag }
+}
+
+// WithDisableScanner returns an option to enable DisableScanner.
+func WithDisableScanner() Option {
+	return func(b *Binder) { b.DisableScanner = true }
+}
+
+// WithIgnoreSetterUnmarshaler returns an option to enable IgnoreSetterUnmarshaler.
+func WithIgnoreSetterUnmarshaler() Option {
+	return func(b *Binder) { b.IgnoreSetterUnmarshaler = true }
+}
+
+// WithPreferSetter returns an option to enable PreferSetter.
+func WithPreferSetter() Option {
+	return func(b *Binder) { b.PreferSetter = true }
+}
+
+// WithDisableTimeSpecialCase returns an option to enable DisableTimeSpecialCase.
+func WithDisableTimeSpecialCase() Option {
+	return func(b *Binder) { b.DisableTimeSpecialCase = true }
+}
+
+// WithMaxDepth returns an option to set MaxDepth.
+func WithMaxDepth(depth int) Option {
+	return func(b *Binder) { b.MaxDepth = depth }
+}
+
+// WithCollectErrors returns an option to enable CollectErrors.
+func WithCollectErrors() Option {
+	return func(b *Binder) { b.CollectErrors = true }
+}
+
+// WithAllowOverflow returns an option to enable AllowOverflow.
+func WithAllowOverflow() Option {
+	return func(b *Binder) { b.AllowOverflow = true }
+}
+
+// WithStrict returns an option to enable Strict,
+// which is equal to Binder.WithStrict(true).
+func WithStrict() Option {
+	return func(b *Binder) { b.Strict = true }
+}
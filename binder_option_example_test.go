@@ -0,0 +1,77 @@
+// Copyright 2023 xgfone
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package binder
+
+import (
+	"fmt"
+	"reflect"
+)
+
+func ExampleNewBinder() {
+	var S struct {
+		Name string `form:"name" json:"nickname"`
+		Age  int    `json:"age"`
+	}
+
+	binder := NewBinder(WithStrict(), WithTags("form", "json"), WithCaseInsensitive())
+	err := binder.Bind(&S, map[string]interface{}{"NAME": "Aaron", "age": 18})
+	fmt.Printf("%+v, %v\n", S, err)
+
+	err = binder.Bind(&S, map[string]interface{}{"age": "18"})
+	fmt.Println(err)
+
+	// Output:
+	// {Name:Aaron Age:18}, <nil>
+	// path "age": cannot bind string to int in strict mode
+}
+
+func ExampleBinder_With() {
+	base := NewBinder(WithTags("json"))
+	query := base.WithTag("query").WithStrict(true)
+	form := base.With(WithTags("form", "json"), WithCaseInsensitive())
+
+	fmt.Printf("base:  TagName=%q, Tags=%v, Strict=%v, CaseInsensitive=%v\n",
+		base.TagName, base.Tags, base.Strict, base.CaseInsensitive)
+	fmt.Printf("query: TagName=%q, Tags=%v, Strict=%v, CaseInsensitive=%v\n",
+		query.TagName, query.Tags, query.Strict, query.CaseInsensitive)
+	fmt.Printf("form:  TagName=%q, Tags=%v, Strict=%v, CaseInsensitive=%v\n",
+		form.TagName, form.Tags, form.Strict, form.CaseInsensitive)
+
+	// The With methods are equal to the options.
+	option := base.With(WithTag("query"), WithStrict())
+	fmt.Printf("option: TagName=%q, Strict=%v\n", option.TagName, option.Strict)
+
+	// Output:
+	// base:  TagName="", Tags=[json], Strict=false, CaseInsensitive=false
+	// query: TagName="query", Tags=[json], Strict=true, CaseInsensitive=false
+	// form:  TagName="", Tags=[form json], Strict=false, CaseInsensitive=true
+	// option: TagName="query", Strict=true
+}
+
+func ExampleBinder_Clone() {
+	base := NewBinder(WithTags("query", "json"), WithNilStrings("null"))
+
+	clone := base.Clone()
+	clone.Tags[0] = "form"
+	clone.NilStrings = append(clone.NilStrings, "undefined")
+	clone.Hook = func(dst reflect.Value, src interface{}) (interface{}, error) { return src, nil }
+
+	fmt.Printf("base:  Tags=%v, NilStrings=%v, Hook=%v\n", base.Tags, base.NilStrings, base.Hook != nil)
+	fmt.Printf("clone: Tags=%v, NilStrings=%v, Hook=%v\n", clone.Tags, clone.NilStrings, clone.Hook != nil)
+
+	// Output:
+	// base:  Tags=[query json], NilStrings=[null], Hook=false
+	// clone: Tags=[form json], NilStrings=[null undefined], Hook=true
+}
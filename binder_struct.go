@@ -0,0 +1,340 @@
+// Copyright 2023 xgfone
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package binder
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/xgfone/go-defaults"
+	"github.com/xgfone/go-defaults/assists"
+	"github.com/xgfone/go-structs/field"
+)
+
+// StructToMap converts the struct to map[string]interface{},
+// which is the inverse of BindStructToMap.
+//
+// For the key name, it uses the tag to get the field name like BindWithTag,
+// and supports the field argument "squash", or its alias "inline",
+// and the ignored field "-".
+// For the field value, time.Time and time.Duration are converted to string,
+// struct is converted to map[string]interface{} recursively, and slice/array
+// is converted to []interface{}.
+func StructToMap(structptr interface{}, tag string) (map[string]interface{}, error) {
+	v, err := getStructValue(structptr)
+	if err != nil {
+		return nil, err
+	}
+
+	e := structEncoder{getFieldName: assists.StructFieldNameFuncWithTags(tag)}
+	return e.encodeStruct(v), nil
+}
+
+// BindStructToStruct binds the struct dst to the struct src, which converts
+// src to map[string]interface{} by StructToMap and binds dst to it
+// with the tag, so the fields with the same name are bound with
+// the type conversion, such as the string id to the int.
+func BindStructToStruct(dst, src interface{}, tag string) error {
+	maps, err := StructToMap(src, tag)
+	if err != nil {
+		return err
+	}
+	return BindWithTag(dst, maps, tag)
+}
+
+// StructToURLValues converts the struct to url.Values,
+// which is the inverse of BindStructToURLValues.
+//
+// For the key name, it uses the tag to get the field name like BindWithTag.
+// For the field value, it is converted to string by defaults.ToString,
+// and the slice/array is converted to the repeated values.
+// The nil pointer field is skipped, and the zero field is also skipped
+// if the field argument contains "omitempty".
+func StructToURLValues(structptr interface{}, tag string) (url.Values, error) {
+	e := structEncoder{getFieldName: assists.StructFieldNameFuncWithTags(tag)}
+	return e.encodeStringValues(structptr)
+}
+
+// StructToHTTPHeader converts the struct to http.Header,
+// which is the inverse of BindStructToHTTPHeader.
+//
+// For the key name, it is normalized by textproto.CanonicalMIMEHeaderKey.
+// For the field value, it is the same as StructToURLValues,
+// that's, the slice/array is converted to the repeated header values.
+func StructToHTTPHeader(structptr interface{}, tag string) (http.Header, error) {
+	e := structEncoder{getFieldName: httpHeaderFieldName(tag)}
+	return e.encodeStringValues(structptr)
+}
+
+func (e structEncoder) encodeStringValues(structptr interface{}) (map[string][]string, error) {
+	v, err := getStructValue(structptr)
+	if err != nil {
+		return nil, err
+	}
+
+	values := make(map[string][]string, v.NumField())
+	e.rangeFields(v, func(name, arg string, value reflect.Value) {
+		if err != nil {
+			return
+		}
+
+		var ss []string
+		if ss, err = e.encodeStrings(value, hasFieldArg(arg, "omitempty")); err != nil {
+			err = fmt.Errorf("field '%s': %w", name, err)
+		} else if len(ss) > 0 {
+			values[name] = ss
+		}
+	})
+
+	return values, err
+}
+
+// StructToStringMap converts the struct to map[string]string,
+// which is the inverse of BindStructToStringMap.
+//
+// For the key name, it uses the tag to get the field name like BindWithTag,
+// and the nested struct is flattened with the dotted keys, such as
+// "address.city".
+// For the field value, it is converted to string like StructToURLValues,
+// and the slice/array is joined by the comma.
+// The nil pointer field is skipped, and the zero field is also skipped
+// if the field argument contains "omitempty".
+func StructToStringMap(structptr interface{}, tag string) (map[string]string, error) {
+	v, err := getStructValue(structptr)
+	if err != nil {
+		return nil, err
+	}
+
+	maps := make(map[string]string, v.NumField())
+	e := structEncoder{getFieldName: assists.StructFieldNameFuncWithTags(tag)}
+	err = e.encodeStringMap(maps, "", v)
+	return maps, err
+}
+
+func getStructValue(structptr interface{}) (v reflect.Value, err error) {
+	v, ok := structptr.(reflect.Value)
+	if !ok {
+		v = reflect.ValueOf(structptr)
+	}
+
+	for v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			return v, fmt.Errorf("%T must not be a nil pointer", structptr)
+		}
+		v = v.Elem()
+	}
+
+	if v.Kind() != reflect.Struct {
+		return v, fmt.Errorf("%T is not a struct or a pointer to struct", structptr)
+	}
+	return
+}
+
+type structEncoder struct {
+	getFieldName func(reflect.StructField) (name, arg string)
+}
+
+// rangeFields calls the function f for each exported field of the struct v,
+// and flattens the anonymous or squashed struct fields, or the non-nil
+// pointers to them, the names of which have the prefix of "squash=prefix"
+// like binding. The nil pointer to the squashed struct is skipped.
+func (e structEncoder) rangeFields(v reflect.Value, f func(name, arg string, value reflect.Value)) {
+	e.rangePrefixedFields(v, "", f)
+}
+
+func (e structEncoder) rangePrefixedFields(v reflect.Value, prefix string, f func(name, arg string, value reflect.Value)) {
+	for index, sf := range field.GetAllFields(v.Type()) {
+		if !sf.IsExported() {
+			continue
+		}
+
+		name, arg := e.getFieldName(sf)
+		if name == "" {
+			continue
+		}
+
+		fieldValue := v.Field(index)
+		if sf.Anonymous || isSquashed(arg) {
+			if structValue, ok := squashedStruct(fieldValue); ok {
+				if structValue.IsValid() {
+					e.rangePrefixedFields(structValue, prefix+getSquashPrefix(arg), f)
+				}
+				continue
+			}
+		}
+
+		f(prefix+name, arg, fieldValue)
+	}
+}
+
+// squashedStruct returns the struct value of the squashed field,
+// which is a struct or a pointer to struct except time.Time.
+//
+// If the field is a nil pointer to struct, return the invalid value and true.
+func squashedStruct(v reflect.Value) (reflect.Value, bool) {
+	t := v.Type()
+	if t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct || isTimeType(t) {
+		return v, false
+	}
+
+	if v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			return reflect.Value{}, true
+		}
+		v = v.Elem()
+	}
+	return v, true
+}
+
+func (e structEncoder) encodeStruct(v reflect.Value) map[string]interface{} {
+	maps := make(map[string]interface{}, v.NumField())
+	e.rangeFields(v, func(name, _ string, value reflect.Value) {
+		maps[name] = e.encodeValue(value)
+	})
+	return maps
+}
+
+func (e structEncoder) encodeValue(v reflect.Value) interface{} {
+	switch v.Kind() {
+	case reflect.Invalid:
+		return nil
+
+	case reflect.Pointer, reflect.Interface:
+		if v.IsNil() {
+			return nil
+		}
+		return e.encodeValue(v.Elem())
+
+	case reflect.Struct:
+		if t, ok := v.Interface().(time.Time); ok {
+			return t.Format(time.RFC3339Nano)
+		}
+		return e.encodeStruct(v)
+
+	case reflect.Int64:
+		if d, ok := v.Interface().(time.Duration); ok {
+			return d.String()
+		}
+
+	case reflect.Array, reflect.Slice:
+		if v.Kind() == reflect.Slice && v.IsNil() {
+			return nil
+		} else if v.Type().Elem().Kind() == reflect.Uint8 {
+			break // []byte
+		}
+
+		_len := v.Len()
+		values := make([]interface{}, _len)
+		for i := 0; i < _len; i++ {
+			values[i] = e.encodeValue(v.Index(i))
+		}
+		return values
+
+	case reflect.Map:
+		if v.IsNil() {
+			return nil
+		}
+
+		maps := make(map[string]interface{}, v.Len())
+		for iter := v.MapRange(); iter.Next(); {
+			maps[fmt.Sprint(iter.Key().Interface())] = e.encodeValue(iter.Value())
+		}
+		return maps
+	}
+
+	return v.Interface()
+}
+
+func (e structEncoder) encodeStringMap(maps map[string]string, prefix string, v reflect.Value) (err error) {
+	e.rangeFields(v, func(name, arg string, value reflect.Value) {
+		if err != nil {
+			return
+		}
+
+		name = prefix + name
+		omitempty := hasFieldArg(arg, "omitempty")
+		for value.Kind() == reflect.Pointer || value.Kind() == reflect.Interface {
+			if value.IsNil() {
+				return
+			}
+			value = value.Elem()
+		}
+
+		if value.Kind() == reflect.Struct && !isTimeType(value.Type()) {
+			if !omitempty || !value.IsZero() {
+				err = e.encodeStringMap(maps, name+".", value)
+			}
+			return
+		}
+
+		var ss []string
+		if ss, err = e.encodeStrings(value, omitempty); err != nil {
+			err = fmt.Errorf("field '%s': %w", name, err)
+		} else if ss != nil {
+			maps[name] = strings.Join(ss, ",")
+		}
+	})
+	return
+}
+
+// encodeStrings converts the value v to a set of strings,
+// which returns nil if v is a nil pointer or is zero and omitempty is true.
+func (e structEncoder) encodeStrings(v reflect.Value, omitempty bool) (ss []string, err error) {
+	for v.Kind() == reflect.Pointer || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return
+		}
+		v = v.Elem()
+	}
+
+	if omitempty && v.IsZero() {
+		return
+	}
+
+	switch v.Kind() {
+	case reflect.Array, reflect.Slice:
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			break // []byte
+		}
+
+		_len := v.Len()
+		ss = make([]string, 0, _len)
+		for i := 0; i < _len; i++ {
+			var s []string
+			if s, err = e.encodeStrings(v.Index(i), false); err != nil {
+				return
+			}
+			ss = append(ss, s...)
+		}
+		return
+	}
+
+	s, err := defaults.ToString(v.Interface())
+	if err == nil {
+		ss = []string{s}
+	}
+	return
+}
+
+var timeType = reflect.TypeOf(time.Time{})
+
+func isTimeType(t reflect.Type) bool { return t == timeType }
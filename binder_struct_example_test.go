@@ -0,0 +1,357 @@
+// Copyright 2023 xgfone
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package binder
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"time"
+)
+
+func ExampleStructToMap() {
+	type Item struct {
+		Name string `json:"name"`
+		Size int    `json:"size"`
+	}
+
+	type Struct struct {
+		Ignore   string        `json:"-"`
+		Name     string        `json:"name"`
+		Timeout  time.Duration `json:"timeout"`
+		Time     time.Time     `json:"time"`
+		Pointer  *int          `json:"pointer"`
+		Items    []Item        `json:"items"`
+		Embed    Item          `json:"embed"`
+		Squashed struct {
+			Field1 int `json:"field1"`
+			Field2 int `json:"field2"`
+		} `json:",squash"`
+	}
+
+	value := 123
+	src := Struct{
+		Ignore:  "ignore",
+		Name:    "abc",
+		Timeout: time.Second * 3,
+		Time:    time.Date(2023, 1, 2, 3, 4, 5, 0, time.UTC),
+		Pointer: &value,
+		Items:   []Item{{Name: "a", Size: 1}, {Name: "b", Size: 2}},
+		Embed:   Item{Name: "c", Size: 3},
+	}
+	src.Squashed.Field1 = 4
+	src.Squashed.Field2 = 5
+
+	maps, err := StructToMap(&src, "json")
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	fmt.Printf("ignore=%v\n", maps["ignore"])
+	fmt.Printf("name=%v\n", maps["name"])
+	fmt.Printf("timeout=%v\n", maps["timeout"])
+	fmt.Printf("time=%v\n", maps["time"])
+	fmt.Printf("pointer=%v\n", maps["pointer"])
+	fmt.Printf("items=%v\n", maps["items"])
+	fmt.Printf("embed=%v\n", maps["embed"])
+	fmt.Printf("field1=%v\n", maps["field1"])
+	fmt.Printf("field2=%v\n", maps["field2"])
+
+	var dst Struct
+	if err := BindStructToMap(&dst, "json", maps); err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	src.Ignore = ""
+	fmt.Printf("roundtrip=%v\n", reflect.DeepEqual(src, dst))
+
+	// Output:
+	// ignore=<nil>
+	// name=abc
+	// timeout=3s
+	// time=2023-01-02T03:04:05Z
+	// pointer=123
+	// items=[map[name:a size:1] map[name:b size:2]]
+	// embed=map[name:c size:3]
+	// field1=4
+	// field2=5
+	// roundtrip=true
+}
+
+func ExampleStructToURLValues() {
+	var value int
+	src := struct {
+		Ignore    string        `query:"-"`
+		Name      string        `query:"name"`
+		Ints      []int         `query:"ints"`
+		Timeout   time.Duration `query:"timeout"`
+		NilPtr    *int          `query:"nilptr"`
+		Ptr       *int          `query:"ptr"`
+		Empty     string        `query:"empty"`
+		OmitEmpty string        `query:"omitempty,omitempty"`
+		OmitZero  int           `query:"omitzero,omitempty"`
+	}{
+		Ignore:  "ignore",
+		Name:    "abc",
+		Ints:    []int{1, 2},
+		Timeout: time.Second,
+		Ptr:     &value,
+	}
+
+	values, err := StructToURLValues(&src, "query")
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	fmt.Println(values.Encode())
+
+	// Output:
+	// empty=&ints=1&ints=2&name=abc&ptr=0&timeout=1s
+}
+
+func ExampleStructToStringMap() {
+	type Address struct {
+		City   string `header:"city"`
+		Street string `header:"street,omitempty"`
+	}
+
+	src := struct {
+		Ignore   string        `header:"-"`
+		Name     string        `header:"name"`
+		Tags     []string      `header:"tags"`
+		Timeout  time.Duration `header:"timeout"`
+		Created  time.Time     `header:"created"`
+		Address  Address       `header:"address"`
+		NilPtr   *Address      `header:"nilptr"`
+		private  string
+		Optional int `header:"optional,omitempty"`
+	}{
+		Ignore:  "ignore",
+		Name:    "abc",
+		Tags:    []string{"a", "b"},
+		Timeout: time.Second,
+		Created: time.Date(2023, 2, 1, 0, 0, 0, 0, time.UTC),
+		Address: Address{City: "Beijing"},
+		private: "private",
+	}
+
+	maps, err := StructToStringMap(&src, "header")
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	keys := make([]string, 0, len(maps))
+	for key := range maps {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		fmt.Printf("%s=%s\n", key, maps[key])
+	}
+
+	// Output:
+	// address.city=Beijing
+	// created=2023-02-01T00:00:00Z
+	// name=abc
+	// tags=a,b
+	// timeout=1s
+}
+
+func ExampleStructToHTTPHeader() {
+	src := struct {
+		Ignore  string        `header:"-"`
+		Ints    []int         `header:"x-ints"`
+		Timeout time.Duration `header:"x-timeout"`
+		NilPtr  *int          `header:"x-nilptr"`
+		Empty   string        `header:"x-empty,omitempty"`
+		Host    string
+	}{
+		Ignore:  "ignore",
+		Ints:    []int{3, 4},
+		Timeout: time.Second,
+		Host:    "localhost",
+	}
+
+	header, err := StructToHTTPHeader(&src, "header")
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	keys := make([]string, 0, len(header))
+	for key := range header {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		for _, value := range header[key] {
+			fmt.Printf("%s: %s\n", key, value)
+		}
+	}
+
+	// Output:
+	// Host: localhost
+	// X-Ints: 3
+	// X-Ints: 4
+	// X-Timeout: 1s
+}
+
+func ExampleBindStructToStruct() {
+	type AddressDTO struct {
+		City string `json:"city"`
+		Zip  string `json:"zip"`
+	}
+
+	type Address struct {
+		City string `json:"city"`
+		Zip  int    `json:"zip"`
+	}
+
+	dto := struct {
+		ID       string        `json:"id"`
+		Name     string        `json:"name"`
+		Age      float64       `json:"age"`
+		Timeout  time.Duration `json:"timeout"`
+		Address  AddressDTO    `json:"address"`
+		Extra    string        `json:"extra"`
+		Optional *string       `json:"optional"`
+	}{
+		ID:      "123",
+		Name:    "Aaron",
+		Age:     18,
+		Timeout: time.Second,
+		Address: AddressDTO{City: "Beijing", Zip: "100000"},
+		Extra:   "extra",
+	}
+
+	var model struct {
+		ID       int           `json:"id"`
+		Name     string        `json:"name"`
+		Age      int           `json:"age"`
+		Timeout  time.Duration `json:"timeout"`
+		Address  *Address      `json:"address"`
+		Optional string        `json:"optional"`
+	}
+	model.Optional = "default"
+
+	if err := BindStructToStruct(&model, &dto, "json"); err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	fmt.Println(model.ID, model.Name, model.Age, model.Timeout, *model.Address, model.Optional)
+
+	dto.ID = "abc"
+	fmt.Println(BindStructToStruct(&model, &dto, "json"))
+
+	// Output:
+	// 123 Aaron 18 1s {Beijing 100000} default
+	// path "id": strconv.ParseInt: parsing "abc": invalid syntax
+}
+
+func ExampleStructToMap_squashPrefix() {
+	type Address struct {
+		City string `json:"city"`
+	}
+
+	type Order struct {
+		Ship Address `json:",squash=ship_"`
+		Bill Address `json:",squash=bill_"`
+	}
+
+	src := Order{Ship: Address{City: "A"}, Bill: Address{City: "B"}}
+	maps, err := StructToMap(src, "json")
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Println(maps)
+
+	var dst Order
+	if err = BindStructToStruct(&dst, src, "json"); err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Printf("%+v\n", dst)
+
+	dst = Order{}
+	if err = BindWithTag(&dst, src, "json"); err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Printf("%+v\n", dst)
+
+	// Output:
+	// map[bill_city:B ship_city:A]
+	// {Ship:{City:A} Bill:{City:B}}
+	// {Ship:{City:A} Bill:{City:B}}
+}
+
+func ExampleStructToMap_embeddedPointer() {
+	type Base struct {
+		ID   int    `json:"id"`
+		Name string `json:"name"`
+	}
+
+	type User struct {
+		*Base
+		Age int `json:"age"`
+	}
+
+	src := User{Base: &Base{ID: 123, Name: "Aaron"}, Age: 18}
+	maps, err := StructToMap(src, "json")
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Println(maps)
+
+	var dst1 User
+	if err = BindStructToMap(&dst1, "json", maps); err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Printf("BindStructToMap: %+v, Age=%d\n", *dst1.Base, dst1.Age)
+
+	var dst2 User
+	if err = BindStructToStruct(&dst2, src, "json"); err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Printf("BindStructToStruct: %+v, Age=%d\n", *dst2.Base, dst2.Age)
+
+	var dst3 User
+	if err = BindWithTag(&dst3, src, "json"); err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Printf("BindWithTag: %+v, Age=%d\n", *dst3.Base, dst3.Age)
+
+	maps, _ = StructToMap(User{Age: 20}, "json")
+	fmt.Println(maps)
+
+	// Output:
+	// map[age:18 id:123 name:Aaron]
+	// BindStructToMap: {ID:123 Name:Aaron}, Age=18
+	// BindStructToStruct: {ID:123 Name:Aaron}, Age=18
+	// BindWithTag: {ID:123 Name:Aaron}, Age=18
+	// map[age:20]
+}
@@ -0,0 +1,128 @@
+// Copyright 2023 xgfone
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package binder
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/xgfone/go-defaults"
+)
+
+// Percent is a ratio, such as 0.75, which implements the interface Setter
+// to bind the percentage string, such as "75%", or the plain number.
+type Percent float64
+
+// Set implements the interface Setter.
+func (p *Percent) Set(src interface{}) (err error) {
+	if s, ok := src.(string); ok {
+		s = strings.TrimSpace(s)
+		if number, ok := strings.CutSuffix(s, "%"); ok {
+			var v float64
+			if v, err = strconv.ParseFloat(strings.TrimSpace(number), 64); err != nil {
+				return fmt.Errorf("invalid percent '%s': %w", s, err)
+			}
+
+			*p = Percent(v / 100)
+			return
+		}
+	}
+
+	v, err := defaults.ToFloat64(src)
+	if err == nil {
+		*p = Percent(v)
+	}
+	return
+}
+
+// ByteSize is the number of bytes, which implements the interface Setter
+// to bind the human-readable size string, such as "512KB" and "1.5MiB",
+// or the plain number.
+//
+// The unit is case-insensitive, and one of B, the SI units K/KB, M/MB, G/GB
+// and T/TB based on 1000, and the IEC units KiB, MiB, GiB and TiB based
+// on 1024, such as "1KB" is 1000 bytes and "1KiB" is 1024 bytes.
+type ByteSize int64
+
+// Set implements the interface Setter.
+func (s *ByteSize) Set(src interface{}) (err error) {
+	if v, ok := src.(string); ok {
+		var size int64
+		if size, err = parseByteSize(v); err != nil {
+			return fmt.Errorf("invalid byte size '%s': %w", v, err)
+		}
+
+		*s = ByteSize(size)
+		return
+	}
+
+	v, err := defaults.ToInt64(src)
+	if err == nil {
+		*s = ByteSize(v)
+	}
+	return
+}
+
+// parseByteSize parses the byte size, such as "1024", "512KB" and "1.5MiB".
+// See ByteSize about the units.
+func parseByteSize(s string) (int64, error) {
+	s = strings.TrimSpace(s)
+	index := strings.IndexFunc(s, func(r rune) bool {
+		return (r < '0' || r > '9') && r != '.'
+	})
+
+	number, unit := s, ""
+	if index > -1 {
+		number, unit = s[:index], strings.TrimSpace(s[index:])
+	}
+
+	var scale float64
+	switch strings.ToUpper(unit) {
+	case "", "B":
+		scale = 1
+	case "K", "KB":
+		scale = 1e3
+	case "M", "MB":
+		scale = 1e6
+	case "G", "GB":
+		scale = 1e9
+	case "T", "TB":
+		scale = 1e12
+	case "KIB":
+		scale = 1 << 10
+	case "MIB":
+		scale = 1 << 20
+	case "GIB":
+		scale = 1 << 30
+	case "TIB":
+		scale = 1 << 40
+	default:
+		return 0, fmt.Errorf("unknown byte size unit '%s'", unit)
+	}
+
+	value, err := strconv.ParseFloat(number, 64)
+	if err != nil {
+		return 0, err
+	}
+
+	// Reject the size which does not fit in int64, instead of wrapping it.
+	if value *= scale; value >= math.MaxInt64 {
+		return 0, errors.New("the size overflows int64")
+	}
+	return int64(value), nil
+}
@@ -0,0 +1,59 @@
+// Copyright 2023 xgfone
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package binder
+
+import "fmt"
+
+func ExamplePercent() {
+	var config struct {
+		Ratio     Percent  `json:"ratio"`
+		Threshold Percent  `json:"threshold"`
+		Plain     Percent  `json:"plain"`
+		Size      ByteSize `json:"size"`
+		Cache     ByteSize `json:"cache"`
+		Bytes     ByteSize `json:"bytes"`
+		SISize    ByteSize `json:"si_size"`
+		IECSize   ByteSize `json:"iec_size"`
+	}
+
+	err := BindStructToMap(&config, "json", map[string]interface{}{
+		"ratio":     "75%",
+		"threshold": "12.5 %",
+		"plain":     0.5,
+		"size":      "10MB",
+		"cache":     "1.5KiB",
+		"bytes":     4096,
+		"si_size":   "1GB",
+		"iec_size":  "1GiB",
+	})
+
+	fmt.Println(err)
+	fmt.Println(config.Ratio, config.Threshold, config.Plain)
+	fmt.Println(config.Size, config.Cache, config.Bytes)
+	fmt.Println(config.SISize, config.IECSize)
+
+	fmt.Println(BindStructToMap(&config, "json", map[string]interface{}{"ratio": "75x%"}))
+	fmt.Println(BindStructToMap(&config, "json", map[string]interface{}{"size": "10XB"}))
+	fmt.Println(BindStructToMap(&config, "json", map[string]interface{}{"size": "100000000TB"}))
+
+	// Output:
+	// <nil>
+	// 0.75 0.125 0.5
+	// 10000000 1536 4096
+	// 1000000000 1073741824
+	// path "ratio": invalid percent '75x%': strconv.ParseFloat: parsing "75x": invalid syntax
+	// path "size": invalid byte size '10XB': unknown byte size unit 'XB'
+	// path "size": invalid byte size '100000000TB': the size overflows int64
+}
@@ -15,13 +15,21 @@
 package binder
 
 import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"encoding/xml"
 	"errors"
 	"fmt"
+	"io"
 	"net/http"
+	"reflect"
 	"strings"
 
 	"github.com/xgfone/go-defaults"
 	"github.com/xgfone/go-defaults/assists"
+	"github.com/xgfone/go-structs"
+	"github.com/xgfone/go-structs/handler/validate"
 )
 
 var errMissingContentType = errors.New("missing the header Content-Type")
@@ -29,7 +37,9 @@ var errMissingContentType = errors.New("missing the header Content-Type")
 // Decoder is used to decode the data src to dst.
 //
 // In general, Deocder is used to decode a byte stream to a type,
-// such as struct or map.
+// such as struct or map. For the decoder registered into MuxDecoder,
+// src is *http.Request, or BytesSource by MuxDecoder.DecodeBytes,
+// which carries the raw data and its content type.
 type Decoder interface {
 	Decode(dst, src interface{}) error
 }
@@ -40,16 +50,46 @@ type DecoderFunc func(dst, src interface{}) error
 // Decode implements the interface Decoder.
 func (f DecoderFunc) Decode(dst, src interface{}) error { return f(dst, src) }
 
+// ContextDecoder is a decoder to decode the data src to dst with the context,
+// which is used to carry the deadline and request-scoped values.
+type ContextDecoder interface {
+	DecodeContext(ctx context.Context, dst, src interface{}) error
+}
+
+// ContextDecoderFunc is a function to decode the data src to dst
+// with the context.
+type ContextDecoderFunc func(ctx context.Context, dst, src interface{}) error
+
+// Decode implements the interface Decoder with context.Background().
+func (f ContextDecoderFunc) Decode(dst, src interface{}) error {
+	return f(context.Background(), dst, src)
+}
+
+// DecodeContext implements the interface ContextDecoder.
+func (f ContextDecoderFunc) DecodeContext(ctx context.Context, dst, src interface{}) error {
+	return f(ctx, dst, src)
+}
+
+// DecodeContext uses the decoder to decode src to dst with the context
+// if it implements the interface ContextDecoder. Or, ignore the context.
+func DecodeContext(ctx context.Context, decoder Decoder, dst, src interface{}) error {
+	if d, ok := decoder.(ContextDecoder); ok {
+		return d.DecodeContext(ctx, dst, src)
+	}
+	return decoder.Decode(dst, src)
+}
+
 // ComposeDecoders composes a group of decoders, which will be called in turn,
-// to a Decoder.
+// to a Decoder, which also implements the interface ContextDecoder
+// to forward the context to the decoders.
 func ComposeDecoders(decoders ...Decoder) Decoder {
 	if len(decoders) == 0 {
 		panic("ComposeDecoders: missing decoders")
 	}
 
-	return DecoderFunc(func(dst, src interface{}) (err error) {
+	return ContextDecoderFunc(func(ctx context.Context, dst, src interface{}) (err error) {
 		for _, decoder := range decoders {
-			if err = decoder.Decode(dst, src); err != nil {
+			if err = DecodeContext(ctx, decoder, dst, src); err != nil {
 				return
 			}
 		}
@@ -57,6 +97,172 @@ func ComposeDecoders(decoders ...Decoder) Decoder {
 	})
 }
 
+// ComposeDecodersCollect is the same as ComposeDecoders, but calls all
+// the decoders even if some of them fail, and returns the combined error
+// by errors.Join.
+func ComposeDecodersCollect(decoders ...Decoder) Decoder {
+	if len(decoders) == 0 {
+		panic("ComposeDecodersCollect: missing decoders")
+	}
+
+	return ContextDecoderFunc(func(ctx context.Context, dst, src interface{}) error {
+		var errs []error
+		for _, decoder := range decoders {
+			if err := DecodeContext(ctx, decoder, dst, src); err != nil {
+				errs = append(errs, err)
+			}
+		}
+		return errors.Join(errs...)
+	})
+}
+
+// ErrBodyTooLarge is returned when the request body exceeds the limit.
+var ErrBodyTooLarge = errors.New("request body too large")
+
+// NewJSONDecoder returns a new decoder to decode the JSON body
+// of *http.Request, the size of which must not exceed maxBytes.
+//
+// Besides *http.Request, the source may also be []byte or io.Reader,
+// such as the file or the message from the queue. The empty source
+// is ignored like the request without the body.
+//
+// If maxBytes is equal to or less than 0, it is unlimited.
+func NewJSONDecoder(maxBytes int64) Decoder {
+	return newBodyDecoder("JSONDecoder", maxBytes, func(r io.Reader, dst interface{}) error {
+		return json.NewDecoder(r).Decode(dst)
+	})
+}
+
+// NewXMLDecoder returns a new decoder to decode the XML body
+// of *http.Request, the size of which must not exceed maxBytes.
+//
+// Besides *http.Request, the source may also be []byte or io.Reader
+// like NewJSONDecoder.
+//
+// If maxBytes is equal to or less than 0, it is unlimited.
+func NewXMLDecoder(maxBytes int64) Decoder {
+	return newBodyDecoder("XMLDecoder", maxBytes, func(r io.Reader, dst interface{}) error {
+		return xml.NewDecoder(r).Decode(dst)
+	})
+}
+
+func newBodyDecoder(name string, maxBytes int64, decode func(io.Reader, interface{}) error) Decoder {
+	return DecoderFunc(func(dst, src interface{}) (err error) {
+		var req *http.Request
+		switch v := src.(type) {
+		case *http.Request:
+			req = v
+
+		case BytesSource:
+			return decodeBytes(v.Data, maxBytes, dst, decode)
+
+		case []byte:
+			return decodeBytes(v, maxBytes, dst, decode)
+
+		case io.Reader:
+			return decodeReader(v, maxBytes, dst, decode)
+
+		default:
+			return fmt.Errorf("binder.%s: unsupport to decode %T", name, src)
+		}
+
+		if req.ContentLength <= 0 {
+			return nil
+		} else if maxBytes <= 0 {
+			return decode(req.Body, dst)
+		} else if req.ContentLength > maxBytes {
+			return fmt.Errorf("%w: exceeds %d bytes", ErrBodyTooLarge, maxBytes)
+		}
+
+		err = decode(http.MaxBytesReader(nil, req.Body, maxBytes), dst)
+		return wrapMaxBytesError(err)
+	})
+}
+
+func decodeBytes(data []byte, maxBytes int64, dst interface{}, decode func(io.Reader, interface{}) error) error {
+	if len(data) == 0 {
+		return nil
+	} else if maxBytes > 0 && int64(len(data)) > maxBytes {
+		return fmt.Errorf("%w: exceeds %d bytes", ErrBodyTooLarge, maxBytes)
+	}
+	return decode(bytes.NewReader(data), dst)
+}
+
+// decodeReader decodes the stream of the unknown length,
+// which ignores the empty stream.
+func decodeReader(r io.Reader, maxBytes int64, dst interface{}, decode func(io.Reader, interface{}) error) (err error) {
+	if maxBytes > 0 {
+		r = http.MaxBytesReader(nil, io.NopCloser(r), maxBytes)
+	}
+
+	if err = decode(r, dst); err == io.EOF {
+		return nil
+	}
+	return wrapMaxBytesError(err)
+}
+
+func wrapMaxBytesError(err error) error {
+	if mbe := (*http.MaxBytesError)(nil); errors.As(err, &mbe) {
+		err = fmt.Errorf("%w: exceeds %d bytes", ErrBodyTooLarge, mbe.Limit)
+	}
+	return err
+}
+
+// NewFormDecoder returns a new decoder to decode the form body
+// of *http.Request with the tag "form", the Content-Type of which is
+// "multipart/form-data" or "application/x-www-form-urlencoded".
+//
+// maxMemory is passed to http.Request.ParseMultipartForm, that's,
+// the non-file parts and up to maxMemory bytes of the file parts
+// are stored in memory, and the remainder is stored on disk
+// in temporary files.
+//
+// Besides *http.Request, the source may also be []byte or io.Reader,
+// which is parsed as "application/x-www-form-urlencoded".
+func NewFormDecoder(maxMemory int64) Decoder {
+	return DecoderFunc(func(dst, src interface{}) (err error) {
+		var req *http.Request
+		switch v := src.(type) {
+		case *http.Request:
+			req = v
+		case BytesSource:
+			req = v.request()
+		case []byte:
+			req = newBodyRequest(formURLEncoded, bytes.NewReader(v), int64(len(v)))
+		case io.Reader:
+			req = newBodyRequest(formURLEncoded, v, -1)
+		default:
+			return fmt.Errorf("binder.FormDecoder: unsupport to decode %T", src)
+		}
+
+		if err = parseForm(req, maxMemory); err != nil {
+			return
+		}
+
+		err = BindStructToURLValues(dst, "form", req.Form)
+		if err == nil && req.MultipartForm != nil && len(req.MultipartForm.File) > 0 {
+			err = BindStructToMultipartFileHeaders(dst, "form", req.MultipartForm.File)
+		}
+
+		return
+	})
+}
+
+// parseForm parses the form body of the http request
+// by the Content-Type.
+func parseForm(req *http.Request, maxMemory int64) error {
+	switch ct := getContentType(req.Header); ct {
+	case "multipart/form-data":
+		return req.ParseMultipartForm(maxMemory)
+
+	case formURLEncoded:
+		return req.ParseForm()
+
+	default:
+		return fmt.Errorf("unsupported Content-Type '%s'", ct)
+	}
+}
+
 // StructValidationDecoder returns a struct validation decoder,
 // which only validates whether the value dst is valid, not decodes any.
 func StructValidationDecoder(validator assists.StructValidator) Decoder {
@@ -70,6 +276,22 @@ func StructValidationDecoder(validator assists.StructValidator) Decoder {
 	})
 }
 
+// StructValidationDecoderWithTag returns a struct validation decoder,
+// which only validates whether the value dst is valid by the rules
+// in the given tag, such as "binding", not decodes any.
+//
+// If validator is nil, use defaults.ValidateWithRule to validate the rules.
+func StructValidationDecoderWithTag(validator assists.RuleValidator, tag string) Decoder {
+	reflector := structs.NewReflector()
+	reflector.Register(tag, validate.ValidateStructFieldRunner(validator))
+	return DecoderFunc(func(dst, src interface{}) (err error) {
+		if dst == nil {
+			return nil
+		}
+		return validateValue(reflector, reflect.ValueOf(dst))
+	})
+}
+
 // MuxDecoder is a multiplexer for kinds of Decoders.
 type MuxDecoder struct {
 	// GetDecoder is used to get the deocder by the funciton get
@@ -82,6 +304,13 @@ type MuxDecoder struct {
 	//   interface{ Type() string }
 	GetDecoder func(src interface{}, get func(string) Decoder) (Decoder, error)
 
+	// DefaultType is the type of the default decoder, which is used
+	// when failing to detect the type of src or no decoder is found,
+	// such as the source of []byte or map[string]interface{}.
+	//
+	// If empty or the default decoder is not added, return the error.
+	DefaultType string
+
 	decoders map[string]Decoder
 }
 
@@ -103,16 +332,42 @@ func (md *MuxDecoder) Del(dtype string) { delete(md.decoders, dtype) }
 // Return nil if not found.
 func (md *MuxDecoder) Get(dtype string) Decoder { return md.decoders[dtype] }
 
-// Decode implements the interface Decoder.
+// DecodeBytes decodes the raw data to dst by the decoder registered
+// for the content type, such as "application/json", which may contain
+// the parameters, such as "multipart/form-data; boundary=xxx".
+//
+// It is useful to decode the data from the non-HTTP transport,
+// or in the test without constructing *http.Request.
+func (md *MuxDecoder) DecodeBytes(dst interface{}, contentType string, data []byte) error {
+	return md.Decode(dst, BytesSource{ContentType: contentType, Data: data})
+}
+
+// Decode implements the interface Decoder with context.Background().
 func (md *MuxDecoder) Decode(dst, src interface{}) (err error) {
+	return md.DecodeContext(context.Background(), dst, src)
+}
+
+// DecodeContext implements the interface ContextDecoder,
+// which forwards the context to the decoder if supported.
+func (md *MuxDecoder) DecodeContext(ctx context.Context, dst, src interface{}) (err error) {
 	var decoder Decoder
 	if md.GetDecoder != nil {
 		decoder, err = md.GetDecoder(src, md.Get)
 	} else {
 		decoder, err = md.getDecoder(src, md.Get)
 	}
+
+	if (err != nil || decoder == nil) && md.DefaultType != "" {
+		if _decoder := md.Get(md.DefaultType); _decoder != nil {
+			decoder, err = _decoder, nil
+		}
+	}
+
 	if err == nil {
-		err = decoder.Decode(dst, src)
+		if decoder == nil {
+			return fmt.Errorf("no decoder for %T", src)
+		}
+		err = DecodeContext(ctx, decoder, dst, src)
 	}
 	return
 }
@@ -149,9 +404,50 @@ func (md *MuxDecoder) getDecoder(src interface{}, get func(string) Decoder) (Dec
 }
 
 func getContentType(header http.Header) string {
-	ct := header.Get("Content-Type")
+	return getMediaType(header.Get("Content-Type"))
+}
+
+// getMediaType returns the media type of the content type
+// without the parameters, such as "charset=utf-8".
+func getMediaType(ct string) string {
 	if index := strings.IndexByte(ct, ';'); index > -1 {
 		ct = strings.TrimSpace(ct[:index])
 	}
 	return ct
 }
+
+// BytesSource is the raw data with the content type, which is passed
+// as the source to the decoder by MuxDecoder.DecodeBytes.
+type BytesSource struct {
+	// ContentType is the content type of the data, which may contain
+	// the parameters, such as "multipart/form-data; boundary=xxx".
+	ContentType string
+
+	// Data is the raw data to be decoded.
+	Data []byte
+}
+
+// DecodeType returns the media type of the content type without
+// the parameters, which is used by MuxDecoder to look up the decoder.
+func (s BytesSource) DecodeType() string { return getMediaType(s.ContentType) }
+
+// request converts the raw data to *http.Request with the content type,
+// which is used by the decoder to parse the form body.
+func (s BytesSource) request() *http.Request {
+	return newBodyRequest(s.ContentType, bytes.NewReader(s.Data), int64(len(s.Data)))
+}
+
+const formURLEncoded = "application/x-www-form-urlencoded"
+
+// newBodyRequest returns a new *http.Request with the body and content type,
+// which is used to parse the form body from the non-HTTP source.
+//
+// If the length of the body is unknown, it is -1.
+func newBodyRequest(contentType string, body io.Reader, length int64) *http.Request {
+	return &http.Request{
+		Method:        http.MethodPost,
+		Header:        http.Header{"Content-Type": []string{contentType}},
+		Body:          io.NopCloser(body),
+		ContentLength: length,
+	}
+}
@@ -0,0 +1,45 @@
+//go:build cbor
+
+// Copyright 2023 xgfone
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package binder
+
+import (
+	"io"
+
+	"github.com/fxamacker/cbor/v2"
+)
+
+// CBORDecoder is the decoder to decode the CBOR body of *http.Request,
+// the size of which must not exceed 10MB.
+//
+// It is only available with the build tag "cbor", and registered
+// into DefaultMuxDecoder with the content-type "application/cbor".
+var CBORDecoder = NewCBORDecoder(defaultMaxBodySize)
+
+// NewCBORDecoder returns a new decoder to decode the CBOR body
+// of *http.Request, the size of which must not exceed maxBytes.
+// The CBOR parse error is returned as it is.
+//
+// If maxBytes is equal to or less than 0, it is unlimited.
+func NewCBORDecoder(maxBytes int64) Decoder {
+	return newBodyDecoder("CBORDecoder", maxBytes, func(r io.Reader, dst interface{}) error {
+		return cbor.NewDecoder(r).Decode(dst)
+	})
+}
+
+func init() {
+	DefaultMuxDecoder.Add("application/cbor", CBORDecoder)
+}
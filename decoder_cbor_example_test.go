@@ -0,0 +1,59 @@
+//go:build cbor
+
+// Copyright 2023 xgfone
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package binder
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+
+	"github.com/fxamacker/cbor/v2"
+)
+
+func ExampleCBORDecoder() {
+	type User struct {
+		Name string `cbor:"name"`
+		Age  int    `cbor:"age"`
+	}
+
+	data, err := cbor.Marshal(User{Name: "Aaron", Age: 18})
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	req, _ := http.NewRequest(http.MethodPost, "http://localhost", bytes.NewReader(data))
+	req.Header.Set("Content-Type", "application/cbor")
+
+	var user User
+	if err = DefaultMuxDecoder.Decode(&user, req); err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Printf("request: %+v\n", user)
+
+	user = User{}
+	if err = DecodeBytes(&user, "application/cbor", data); err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Printf("bytes: %+v\n", user)
+
+	// Output:
+	// request: {Name:Aaron Age:18}
+	// bytes: {Name:Aaron Age:18}
+}
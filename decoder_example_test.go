@@ -0,0 +1,341 @@
+// Copyright 2023 xgfone
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package binder
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/xgfone/go-defaults/assists"
+)
+
+func ExampleStructValidationDecoderWithTag() {
+	validator := assists.RuleValidateFunc(func(value interface{}, rule string) error {
+		switch rule {
+		case "required":
+			if value == "" {
+				return errors.New("the value is required")
+			}
+		default:
+			return fmt.Errorf("unknown rule '%s'", rule)
+		}
+		return nil
+	})
+
+	type Request struct {
+		Name string `json:"name" binding:"required"`
+		Desc string `json:"desc" validate:"unknown"` // Ignored
+	}
+
+	decoder := StructValidationDecoderWithTag(validator, "binding")
+	fmt.Println(decoder.Decode(&Request{Name: "Aaron"}, nil))
+	fmt.Println(decoder.Decode(&Request{}, nil))
+	fmt.Println(decoder.Decode([]*Request{{Name: "Aaron"}, {}}, nil))
+
+	// Output:
+	// <nil>
+	// name: the value is required
+	// name: the value is required
+}
+
+func ExampleNewFormDecoder() {
+	body := new(bytes.Buffer)
+	writer := multipart.NewWriter(body)
+	_ = writer.WriteField("name", "Aaron")
+	w, _ := writer.CreateFormFile("file", "file.txt")
+	_, _ = io.WriteString(w, "file content")
+	_ = writer.Close()
+
+	req, _ := http.NewRequest(http.MethodPost, "http://localhost", body)
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+
+	var dst struct {
+		Name string `form:"name"`
+		File []byte `form:"file"`
+	}
+
+	// Store at most 1KB of the files in memory, and the rest on disk.
+	decoder := NewMuxDecoder()
+	decoder.Add("multipart/form-data", NewFormDecoder(1<<10))
+	if err := decoder.Decode(&dst, req); err != nil {
+		fmt.Println(err)
+		return
+	}
+	defer func() { _ = req.MultipartForm.RemoveAll() }()
+
+	fmt.Printf("Name=%s, File=%s\n", dst.Name, dst.File)
+
+	// Output:
+	// Name=Aaron, File=file content
+}
+
+func ExampleNewJSONDecoder() {
+	var dst struct {
+		Name string `json:"name"`
+	}
+
+	decoder := NewJSONDecoder(32)
+	newRequest := func(body string) *http.Request {
+		req, _ := http.NewRequest(http.MethodPost, "http://localhost", strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		return req
+	}
+
+	err := decoder.Decode(&dst, newRequest(`{"name":"Aaron"}`))
+	fmt.Println(dst.Name, err)
+
+	err = decoder.Decode(&dst, newRequest(`{"name":"`+strings.Repeat("a", 32)+`"}`))
+	fmt.Println(errors.Is(err, ErrBodyTooLarge), err)
+
+	// The Content-Length header understates the body size.
+	req := newRequest(`{"name":"` + strings.Repeat("a", 32) + `"}`)
+	req.ContentLength = 16
+	err = decoder.Decode(&dst, req)
+	fmt.Println(errors.Is(err, ErrBodyTooLarge), err)
+
+	// Output:
+	// Aaron <nil>
+	// true request body too large: exceeds 32 bytes
+	// true request body too large: exceeds 32 bytes
+}
+
+func ExampleMuxDecoder_DefaultType() {
+	decoder := NewMuxDecoder()
+	decoder.Add("map", DecoderFunc(func(dst, src interface{}) error { return Bind(dst, src) }))
+	decoder.Add("json", DecoderFunc(func(dst, src interface{}) error {
+		return json.Unmarshal(src.([]byte), dst)
+	}))
+
+	var dst struct {
+		Name string `json:"name"`
+	}
+
+	err := decoder.Decode(&dst, map[string]interface{}{"name": "Aaron"})
+	fmt.Println(err)
+
+	decoder.DefaultType = "map"
+	err = decoder.Decode(&dst, map[string]interface{}{"name": "Aaron"})
+	fmt.Println(dst.Name, err)
+
+	decoder.DefaultType = "json"
+	err = decoder.Decode(&dst, []byte(`{"name":"Bob"}`))
+	fmt.Println(dst.Name, err)
+
+	// Output:
+	// unknown request data type map[string]interface {}
+	// Aaron <nil>
+	// Bob <nil>
+}
+
+func ExampleComposeDecodersCollect() {
+	type Request struct {
+		Name string `json:"name"`
+		Age  int    `json:"age"`
+	}
+
+	decode := DecoderFunc(func(dst, src interface{}) error { return Bind(dst, src) })
+	checkName := DecoderFunc(func(dst, src interface{}) error {
+		if dst.(*Request).Name == "" {
+			return errors.New("missing name")
+		}
+		return nil
+	})
+	checkAge := DecoderFunc(func(dst, src interface{}) error {
+		if age := dst.(*Request).Age; age <= 0 {
+			return fmt.Errorf("invalid age %d", age)
+		}
+		return nil
+	})
+
+	var req Request
+	src := map[string]interface{}{"age": -1}
+
+	err := ComposeDecoders(decode, checkName, checkAge).Decode(&req, src)
+	fmt.Println(err)
+
+	err = ComposeDecodersCollect(decode, checkName, checkAge).Decode(&req, src)
+	fmt.Println(err)
+
+	// Output:
+	// missing name
+	// missing name
+	// invalid age -1
+}
+
+func ExampleDecodeRequestWithMetadata() {
+	var req struct {
+		Page      int    `query:"page"`
+		RequestID string `header:"X-Request-Id"`
+		Name      string `form:"name"`
+		Age       int    `form:"age"`
+		Filter    struct {
+			Status string `query:"status"`
+		} `query:"filter,json"`
+	}
+
+	// The nested keys of the filter, such as "filter.sort", are also reported.
+	query := "page=2&debug=true&filter=" + url.QueryEscape(`{"status":"open","sort":"name"}`)
+	body := strings.NewReader("name=Aaron&age=18&nickname=aaron")
+	httpReq, _ := http.NewRequest("POST", "http://localhost/users?"+query, body)
+	httpReq.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	httpReq.Header.Set("X-Request-Id", "abc")
+
+	md, err := DecodeRequestWithMetadata(&req, httpReq)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	fmt.Printf("Page=%d, RequestID=%s, Name=%s, Age=%d, Status=%s\n",
+		req.Page, req.RequestID, req.Name, req.Age, req.Filter.Status)
+	fmt.Println("Sources:", md.Sources)
+	fmt.Println("Used:", md.Used)
+	fmt.Println("Ignored:", md.Ignored)
+
+	// Output:
+	// Page=2, RequestID=abc, Name=Aaron, Age=18, Status=open
+	// Sources: [query header form]
+	// Used: [query.filter query.filter.status query.page header.X-Request-Id form.age form.name]
+	// Ignored: [query.debug query.filter.sort header.Content-Type form.nickname]
+}
+
+func ExampleDecodeContext() {
+	type ctxkey struct{}
+
+	decoder := ComposeDecoders(
+		DecoderFunc(func(dst, src interface{}) error {
+			dst.(map[string]string)["name"] = src.(string)
+			return nil
+		}),
+		ContextDecoderFunc(func(ctx context.Context, dst, src interface{}) error {
+			dst.(map[string]string)["tenant"] = ctx.Value(ctxkey{}).(string)
+			return nil
+		}),
+	)
+
+	dst := make(map[string]string)
+	ctx := context.WithValue(context.Background(), ctxkey{}, "tenant1")
+	if err := DecodeContext(ctx, decoder, dst, "Aaron"); err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	fmt.Println(dst["name"], dst["tenant"])
+
+	// Output:
+	// Aaron tenant1
+}
+
+func ExampleDecodeBytes() {
+	var user struct {
+		Name string `json:"name" form:"name"`
+		Age  int    `json:"age" form:"age"`
+	}
+
+	err := DecodeBytes(&user, "application/json; charset=utf-8", []byte(`{"name":"Aaron","age":18}`))
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Printf("json: %+v\n", user)
+
+	err = DecodeBytes(&user, "application/x-www-form-urlencoded", []byte("name=Bob&age=20"))
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Printf("form: %+v\n", user)
+
+	err = DecodeBytes(&user, "application/unknown", []byte("data"))
+	fmt.Println(err)
+
+	// Output:
+	// json: {Name:Aaron Age:18}
+	// form: {Name:Bob Age:20}
+	// unsupported request data type 'application/unknown'
+}
+
+func ExampleMuxDecoder_DecodeBytes() {
+	// The custom decoder to decode the text "key=value" lines into the map.
+	lines := DecoderFunc(func(dst, src interface{}) error {
+		source, ok := src.(BytesSource)
+		if !ok {
+			return fmt.Errorf("unsupport to decode %T", src)
+		}
+
+		maps := dst.(map[string]string)
+		for _, line := range strings.Split(string(source.Data), "\n") {
+			if key, value, ok := strings.Cut(line, "="); ok {
+				maps[key] = value
+			}
+		}
+		return nil
+	})
+
+	decoder := NewMuxDecoder()
+	decoder.Add("text/lines", lines)
+
+	maps := make(map[string]string)
+	err := decoder.DecodeBytes(maps, "text/lines; charset=utf-8", []byte("a=1\nb=2"))
+	fmt.Println(err, maps)
+
+	// Output:
+	// <nil> map[a:1 b:2]
+}
+
+func ExampleNewJSONDecoder_reader() {
+	var user struct {
+		Name string `json:"name" form:"name"`
+		Age  int    `json:"age" form:"age"`
+	}
+
+	decoder := NewJSONDecoder(32)
+	if err := decoder.Decode(&user, strings.NewReader(`{"name":"Aaron","age":18}`)); err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Printf("reader: %+v\n", user)
+
+	if err := decoder.Decode(&user, []byte(`{"name":"Bob","age":20}`)); err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Printf("bytes: %+v\n", user)
+
+	fmt.Println(decoder.Decode(&user, strings.NewReader("")))
+	err := decoder.Decode(&user, strings.NewReader(`{"name":"`+strings.Repeat("a", 32)+`"}`))
+	fmt.Println(errors.Is(err, ErrBodyTooLarge))
+
+	if err = NewFormDecoder(1024).Decode(&user, strings.NewReader("name=Carol&age=22")); err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Printf("form: %+v\n", user)
+
+	// Output:
+	// reader: {Name:Aaron Age:18}
+	// bytes: {Name:Bob Age:20}
+	// <nil>
+	// true
+	// form: {Name:Carol Age:22}
+}
@@ -15,8 +15,6 @@
 package binder
 
 import (
-	"encoding/json"
-	"encoding/xml"
 	"fmt"
 	"net/http"
 	"reflect"
@@ -27,6 +25,11 @@ import (
 	"github.com/xgfone/go-validation"
 )
 
+// defaultMaxBodySize is the default limit of the request body size
+// for the JSON and XML decoders, and the default max memory for the form
+// decoder registered in DefaultMuxDecoder.
+const defaultMaxBodySize = 10 << 20
+
 // Predefine some decoders to decode a value,
 // such as body, query and header of the http request.
 var (
@@ -52,8 +55,13 @@ var (
 	//   - "application/json"
 	//   - "multipart/form-data"
 	//   - "application/x-www-form-urlencoded"
+	//   - "application/msgpack" and "application/x-msgpack",
+	//     only with the build tag "msgpack"
+	//   - "application/cbor", only with the build tag "cbor"
 	// For the http request, it can be used like
 	//   DefaultMuxDecoder.Decode(dst, httpRequest).
+	//
+	// The JSON, XML, MessagePack and CBOR body is limited to 10MB.
 	DefaultMuxDecoder = NewMuxDecoder()
 
 	// It will use defaults.ValidateStruct to validate the struct value by default.
@@ -65,6 +73,13 @@ var (
 	HeaderDecoder Decoder = ComposeDecoders(DefaultHeaderDecoder, DefaultStructValidationDecoder)
 )
 
+// DecodeBytes decodes the raw data with the content type to dst
+// by DefaultMuxDecoder, which is equal to
+// DefaultMuxDecoder.DecodeBytes(dst, contentType, data).
+func DecodeBytes(dst interface{}, contentType string, data []byte) error {
+	return DefaultMuxDecoder.DecodeBytes(dst, contentType, data)
+}
+
 func init() {
 	if defaults.RuleValidator.Get() == nil {
 		defaults.RuleValidator.Set(assists.RuleValidateFunc(validation.Validate))
@@ -74,31 +89,26 @@ func init() {
 			if v == nil {
 				return nil
 			}
-			return validate(reflect.ValueOf(v))
+			return validateValue(structs.DefaultReflector, reflect.ValueOf(v))
 		}))
 	}
 
-	DefaultMuxDecoder.Add("application/json", DecoderFunc(func(dst, src interface{}) error {
-		if req := src.(*http.Request); req.ContentLength > 0 {
-			return json.NewDecoder(req.Body).Decode(dst)
-		}
-		return nil
-	}))
+	DefaultMuxDecoder.Add("application/json", NewJSONDecoder(defaultMaxBodySize))
 }
 
-func validate(vf reflect.Value) (err error) {
+func validateValue(r *structs.Reflector, vf reflect.Value) (err error) {
 	switch vf.Kind() {
 	case reflect.Struct:
-		err = structs.ReflectValue(vf)
+		err = r.ReflectValue(vf)
 
 	case reflect.Pointer:
 		if !vf.IsNil() {
-			err = validate(vf.Elem())
+			err = validateValue(r, vf.Elem())
 		}
 
 	case reflect.Array, reflect.Slice:
 		for i, _len := 0, vf.Len(); i < _len; i++ {
-			if err = validate(vf.Index(i)); err != nil {
+			if err = validateValue(r, vf.Index(i)); err != nil {
 				return
 			}
 		}
@@ -108,12 +118,7 @@ func validate(vf reflect.Value) (err error) {
 }
 
 func init() {
-	DefaultMuxDecoder.Add("application/xml", DecoderFunc(func(dst, src interface{}) error {
-		if req := src.(*http.Request); req.ContentLength > 0 {
-			return xml.NewDecoder(req.Body).Decode(dst)
-		}
-		return nil
-	}))
+	DefaultMuxDecoder.Add("application/xml", NewXMLDecoder(defaultMaxBodySize))
 }
 
 func init() {
@@ -122,29 +127,5 @@ func init() {
 }
 
 func registerFormDecoder(ct string) {
-	const maxMemory = 10 << 20
-	DefaultMuxDecoder.Add(ct, DecoderFunc(func(dst, src interface{}) (err error) {
-		req := src.(*http.Request)
-		switch ct := getContentType(req.Header); ct {
-		case "multipart/form-data":
-			err = req.ParseMultipartForm(maxMemory)
-
-		case "application/x-www-form-urlencoded":
-			err = req.ParseForm()
-
-		default:
-			return fmt.Errorf("unsupported Content-Type '%s'", ct)
-		}
-
-		if err != nil {
-			return
-		}
-
-		err = BindStructToURLValues(dst, "form", req.Form)
-		if err == nil && req.MultipartForm != nil && len(req.MultipartForm.File) > 0 {
-			err = BindStructToMultipartFileHeaders(dst, "form", req.MultipartForm.File)
-		}
-
-		return
-	}))
+	DefaultMuxDecoder.Add(ct, NewFormDecoder(defaultMaxBodySize))
 }
@@ -0,0 +1,185 @@
+// Copyright 2023 xgfone
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package binder
+
+import (
+	"net/http"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// Metadata is the metadata of decoding the http request,
+// which is used to inspect which source keys are bound or not.
+type Metadata struct {
+	// Sources is the list of the decoded sources in turn, such as
+	// "query", "header", "form", or the Content-Type of the body
+	// decoded by DefaultMuxDecoder, such as "application/json".
+	Sources []string
+
+	// Used is the list of the source keys bound to the struct fields,
+	// which are prefixed by the source, such as "query.page".
+	//
+	// Notice: it is empty for the body decoded by DefaultMuxDecoder.
+	Used []string
+
+	// Ignored is the list of the source keys not bound to any struct field,
+	// which are prefixed by the source, such as "header.User-Agent",
+	// including the keys of the nested source, such as "query.filter.sort".
+	//
+	// Notice: it is empty for the body decoded by DefaultMuxDecoder.
+	Ignored []string
+}
+
+// DecodeRequestWithMetadata decodes the query with the tag "query",
+// the header with the tag "header", and the body of the http request
+// into dst in turn, then validates dst by DefaultStructValidationDecoder,
+// and returns the metadata about which source keys are bound.
+//
+// For the form body, only the body form, not including the query,
+// is bound with the tag "form". The other body, such as JSON and XML,
+// is decoded by DefaultMuxDecoder, which only reports the source.
+// And the empty body without Content-Type is skipped.
+//
+// The metadata is returned even if failing to decode.
+func DecodeRequestWithMetadata(dst interface{}, req *http.Request) (md *Metadata, err error) {
+	md = new(Metadata)
+
+	md.Sources = append(md.Sources, "query")
+	if err = md.bind("query", newBinderWithTag("query"), dst, req.URL.Query()); err != nil {
+		return
+	}
+
+	md.Sources = append(md.Sources, "header")
+	if err = md.bind("header", newHTTPHeaderBinder("header"), dst, req.Header); err != nil {
+		return
+	}
+
+	if err = md.decodeBody(dst, req); err != nil {
+		return
+	}
+
+	err = DefaultStructValidationDecoder.Decode(dst, req)
+	return
+}
+
+func (md *Metadata) decodeBody(dst interface{}, req *http.Request) (err error) {
+	switch ct := getContentType(req.Header); ct {
+	case "":
+		if req.ContentLength != 0 {
+			return errMissingContentType
+		}
+
+	case "multipart/form-data", "application/x-www-form-urlencoded":
+		if err = parseForm(req, defaultMaxBodySize); err != nil {
+			return
+		}
+
+		md.Sources = append(md.Sources, "form")
+		err = md.bind("form", newBinderWithTag("form"), dst, req.PostForm)
+		if err == nil && req.MultipartForm != nil && len(req.MultipartForm.File) > 0 {
+			err = md.bind("form", newMultipartFileBinder("form"), dst, req.MultipartForm.File)
+		}
+
+	default:
+		md.Sources = append(md.Sources, ct)
+		err = DefaultMuxDecoder.Decode(dst, req)
+	}
+
+	return
+}
+
+// bind binds dst to the map data by binder, and records the source keys
+// which are bound to the struct fields or not.
+//
+// The keys of the nested map bound to the nested struct are also recorded
+// by their paths, such as "sub.a", which are compared with the field paths.
+// But the keys of the map bound to the struct as a whole, such as time.Time
+// or the struct implementing Unmarshaler, are not recorded.
+func (md *Metadata) bind(source string, binder Binder, dst, data interface{}) error {
+	matched := make(map[string]struct{}, 8)
+	structs := make(map[string]struct{}, 4) // The paths of the visited structs.
+	binder.OnField = func(path string, _ reflect.StructField, ok bool) {
+		if index := strings.LastIndexByte(path, '.'); index < 0 {
+			structs[""] = struct{}{}
+		} else {
+			structs[path[:index]] = struct{}{}
+		}
+
+		if ok {
+			matched[path] = struct{}{}
+		}
+	}
+
+	keys := make(map[string]string, 8) // The map from the key path to the struct path.
+	hook := binder.PathHook
+	binder.PathHook = func(path string, dst reflect.Value, src interface{}) (interface{}, error) {
+		if hook != nil {
+			var err error
+			if src, err = hook(path, dst, src); err != nil || src == nil {
+				return src, err
+			}
+		}
+
+		if dst.Kind() == reflect.Struct {
+			addSourceKeys(keys, path, src)
+		}
+		return src, nil
+	}
+
+	err := binder.Bind(dst, data)
+
+	used := make([]string, 0, len(matched))
+	for path := range matched {
+		used = append(used, path)
+	}
+	sort.Strings(used)
+	for _, path := range used {
+		md.Used = append(md.Used, source+"."+path)
+	}
+
+	ignored := make([]string, 0, len(keys))
+	for key, path := range keys {
+		if _, ok := structs[path]; !ok {
+			continue
+		}
+		if _, ok := matched[key]; !ok {
+			ignored = append(ignored, key)
+		}
+	}
+	sort.Strings(ignored)
+	for _, key := range ignored {
+		md.Ignored = append(md.Ignored, source+"."+key)
+	}
+
+	return err
+}
+
+// addSourceKeys adds the string keys of the map src, prefixed by path,
+// into keys.
+func addSourceKeys(keys map[string]string, path string, src interface{}) {
+	v := reflect.ValueOf(src)
+	if v.Kind() != reflect.Map || v.Type().Key().Kind() != reflect.String {
+		return
+	}
+
+	for _, key := range v.MapKeys() {
+		if path == "" {
+			keys[key.String()] = path
+		} else {
+			keys[path+"."+key.String()] = path
+		}
+	}
+}
@@ -0,0 +1,46 @@
+//go:build msgpack
+
+// Copyright 2023 xgfone
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package binder
+
+import (
+	"io"
+
+	"github.com/vmihailenco/msgpack/v5"
+)
+
+// MsgpackDecoder is the decoder to decode the MessagePack body
+// of *http.Request, the size of which must not exceed 10MB.
+//
+// It is only available with the build tag "msgpack", and registered
+// into DefaultMuxDecoder with the content-types "application/msgpack"
+// and "application/x-msgpack".
+var MsgpackDecoder = NewMsgpackDecoder(defaultMaxBodySize)
+
+// NewMsgpackDecoder returns a new decoder to decode the MessagePack body
+// of *http.Request, the size of which must not exceed maxBytes.
+//
+// If maxBytes is equal to or less than 0, it is unlimited.
+func NewMsgpackDecoder(maxBytes int64) Decoder {
+	return newBodyDecoder("MsgpackDecoder", maxBytes, func(r io.Reader, dst interface{}) error {
+		return msgpack.NewDecoder(r).Decode(dst)
+	})
+}
+
+func init() {
+	DefaultMuxDecoder.Add("application/msgpack", MsgpackDecoder)
+	DefaultMuxDecoder.Add("application/x-msgpack", MsgpackDecoder)
+}
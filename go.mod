@@ -1,12 +1,16 @@
 module github.com/xgfone/go-binder
 
 require (
+	github.com/fxamacker/cbor/v2 v2.9.4
+	github.com/vmihailenco/msgpack/v5 v5.4.1
 	github.com/xgfone/go-defaults v0.20.0
 	github.com/xgfone/go-structs v0.3.1
 	github.com/xgfone/go-validation v0.3.0
 )
 
 require (
+	github.com/vmihailenco/tagparser/v2 v2.0.0 // indirect
+	github.com/x448/float16 v0.8.4 // indirect
 	github.com/xgfone/go-toolkit v0.1.1 // indirect
 	github.com/xgfone/predicate v1.3.3 // indirect
 )